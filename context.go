@@ -5,7 +5,9 @@ import (
 	"net/http"
 
 	"github.com/pawelWritesCode/gdutils/pkg/cache"
+	"github.com/pawelWritesCode/gdutils/pkg/comparator"
 	"github.com/pawelWritesCode/gdutils/pkg/debugger"
+	"github.com/pawelWritesCode/gdutils/pkg/httpctx"
 	"github.com/pawelWritesCode/gdutils/pkg/osutils"
 	"github.com/pawelWritesCode/gdutils/pkg/pathfinder"
 	"github.com/pawelWritesCode/gdutils/pkg/schema"
@@ -32,6 +34,9 @@ type APIContext struct {
 	// SchemaValidators holds validators available to validate data against schemas.
 	SchemaValidators SchemaValidators
 
+	// SchemaGenerators holds generators available to generate data valid against schemas.
+	SchemaGenerators SchemaGenerators
+
 	// PathFinders are entities that has ability to obtain data from different data formats.
 	PathFinders PathFinders
 
@@ -41,10 +46,38 @@ type APIContext struct {
 	// TypeMappers are entities that has ability to map underlying data type into different format data type.
 	TypeMappers TypeMappers
 
+	// ComparisonOptions describes how whole documents are compared, for example array order insensitivity.
+	ComparisonOptions comparator.Options
+
+	// SecurityHeadersPolicy describes security headers expected by AssertResponseSecurityHeaders.
+	SecurityHeadersPolicy httpctx.SecurityHeadersPolicy
+
+	// ResponseBodyOptions describes how HTTP(s) response bodies are read, for example their maximum size.
+	ResponseBodyOptions httpctx.ResponseBodyOptions
+
+	// isSoftAssertionMode tells whether Assert* methods record failures instead of returning them, see AssertAll.
+	isSoftAssertionMode bool
+
+	// softAssertionFailures are failures recorded in soft assertion mode since last AssertAll.
+	softAssertionFailures []SoftAssertionFailure
+
+	// nodeSourceCacheKey is cache key of document used by node assertions instead of last HTTP(s) response body,
+	// see SetCachedDocumentAsNodeSource.
+	nodeSourceCacheKey string
+
 	// fileRecognizer is entity that has ability to recognize file reference.
 	fileRecognizer fileRecognizer
 }
 
+// SoftAssertionFailure is failure of assertion recorded in soft assertion mode.
+type SoftAssertionFailure struct {
+	// Assertion is name of assertion method together with its arguments.
+	Assertion string
+
+	// Err is error returned by assertion.
+	Err error
+}
+
 // Serializers is container for entities that know how to serialize and deserialize data.
 type Serializers struct {
 	// JSON is entity that has ability to serialize and deserialize JSON bytes.
@@ -67,6 +100,16 @@ type SchemaValidators struct {
 	ReferenceValidator validator.SchemaValidator
 }
 
+// SchemaGenerators is container for JSON schema data generators.
+type SchemaGenerators struct {
+	// StringGenerator represents entity that has ability to generate data valid against string containing schema.
+	StringGenerator schemaGenerator
+
+	// ReferenceGenerator represents entity that has ability to generate data valid against schema passed as reference,
+	// which may be URL or relative/full OS path for example.
+	ReferenceGenerator schemaGenerator
+}
+
 // PathFinders is container for different data types pathfinders.
 type PathFinders struct {
 	// JSON is entity that has ability to obtain data from bytes in JSON format.
@@ -145,7 +188,10 @@ func NewDefaultAPIContext(isDebug bool, jsonSchemaDir string) *APIContext {
 
 	defaultDebugger := debugger.NewDefault(isDebug)
 
-	return NewAPIContext(defaultHttpClient, defaultCache, jsonSchemaValidators, pathFinders, serializers, typeMappers, defaultDebugger)
+	apiCtx := NewAPIContext(defaultHttpClient, defaultCache, jsonSchemaValidators, pathFinders, serializers, typeMappers, defaultDebugger)
+	apiCtx.SetSchemaReferenceGenerator(schema.NewDefaultJSONSchemaReferenceGenerator(jsonSchemaDir))
+
+	return apiCtx
 }
 
 // NewAPIContext returns *APIContext
@@ -156,17 +202,26 @@ func NewAPIContext(cli *http.Client, c cacheable, jv SchemaValidators, p PathFin
 		RequestDoer:      cli,
 		TemplateEngine:   template.New(),
 		SchemaValidators: jv,
-		PathFinders:      p,
-		Serializers:      s,
-		TypeMappers:      t,
-		fileRecognizer:   osutils.NewOSFileRecognizer("file://", osutils.NewFileValidator()),
+		SchemaGenerators: SchemaGenerators{
+			StringGenerator:    schema.NewJSONSchemaRawGenerator(),
+			ReferenceGenerator: schema.NewDefaultJSONSchemaReferenceGenerator(""),
+		},
+		PathFinders:           p,
+		Serializers:           s,
+		TypeMappers:           t,
+		SecurityHeadersPolicy: httpctx.DefaultSecurityHeadersPolicy(),
+		fileRecognizer:        osutils.NewOSFileRecognizer("file://", osutils.NewFileValidator()),
 	}
 }
 
 // ResetState resets state of APIContext to initial.
 func (apiCtx *APIContext) ResetState(isDebug bool) {
+	apiCtx.removeLastResponseBodyFile()
 	apiCtx.Cache.Reset()
 	apiCtx.Debugger.Reset(isDebug)
+	apiCtx.softAssertionFailures = nil
+	apiCtx.isSoftAssertionMode = false
+	apiCtx.nodeSourceCacheKey = ""
 }
 
 // SetDebugger sets new debugger for APIContext.
@@ -199,6 +254,37 @@ func (apiCtx *APIContext) SetSchemaReferenceValidator(j validator.SchemaValidato
 	apiCtx.SchemaValidators.ReferenceValidator = j
 }
 
+// SetSchemaStringGenerator sets new schema StringGenerator for APIContext.
+func (apiCtx *APIContext) SetSchemaStringGenerator(g schemaGenerator) {
+	apiCtx.SchemaGenerators.StringGenerator = g
+}
+
+// SetSchemaReferenceGenerator sets new schema ReferenceGenerator for APIContext.
+func (apiCtx *APIContext) SetSchemaReferenceGenerator(g schemaGenerator) {
+	apiCtx.SchemaGenerators.ReferenceGenerator = g
+}
+
+// SetComparisonOptions sets new ComparisonOptions for APIContext.
+func (apiCtx *APIContext) SetComparisonOptions(o comparator.Options) {
+	apiCtx.ComparisonOptions = o
+}
+
+// SetSecurityHeadersPolicy sets new SecurityHeadersPolicy for APIContext.
+func (apiCtx *APIContext) SetSecurityHeadersPolicy(p httpctx.SecurityHeadersPolicy) {
+	apiCtx.SecurityHeadersPolicy = p
+}
+
+// SetResponseBodyOptions sets new ResponseBodyOptions for APIContext.
+func (apiCtx *APIContext) SetResponseBodyOptions(o httpctx.ResponseBodyOptions) {
+	apiCtx.ResponseBodyOptions = o
+}
+
+// SetSoftAssertionMode turns on or off soft assertion mode, in which Assert* methods record failures
+// instead of returning them. Recorded failures are reported by AssertAll.
+func (apiCtx *APIContext) SetSoftAssertionMode(isOn bool) {
+	apiCtx.isSoftAssertionMode = isOn
+}
+
 // SetJSONPathFinder sets new JSON pathfinder for APIContext.
 func (apiCtx *APIContext) SetJSONPathFinder(r pathFinder) {
 	apiCtx.PathFinders.JSON = r
@@ -6,7 +6,9 @@ import (
 	"testing"
 
 	"github.com/pawelWritesCode/gdutils/pkg/cache"
+	"github.com/pawelWritesCode/gdutils/pkg/comparator"
 	"github.com/pawelWritesCode/gdutils/pkg/debugger"
+	"github.com/pawelWritesCode/gdutils/pkg/httpctx"
 	"github.com/pawelWritesCode/gdutils/pkg/pathfinder"
 	"github.com/pawelWritesCode/gdutils/pkg/schema"
 	"github.com/pawelWritesCode/gdutils/pkg/serializer"
@@ -90,6 +92,9 @@ func (n newDebugger) Reset(isOn bool) {
 func TestState_ResetState(t *testing.T) {
 	s := NewDefaultAPIContext(true, "")
 	s.Cache.Save("test", 1)
+	if err := s.SetCachedDocumentAsNodeSource("test"); err != nil {
+		t.Fatalf("SetCachedDocumentAsNodeSource() error = %v", err)
+	}
 
 	s.ResetState(false)
 
@@ -100,6 +105,10 @@ func TestState_ResetState(t *testing.T) {
 	if !reflect.DeepEqual(s.Cache.All(), map[string]any{}) {
 		t.Errorf("cache did not reset")
 	}
+
+	if s.nodeSourceCacheKey != "" {
+		t.Errorf("node source did not reset")
+	}
 }
 
 func TestState_SetDebugger(t *testing.T) {
@@ -292,3 +301,101 @@ func TestState_SetXMLSerializer(t *testing.T) {
 		t.Errorf("SetXMLSerializer does not work properly")
 	}
 }
+
+type newSchemaGenerator struct{}
+
+func (n newSchemaGenerator) Generate(schemaPath string, mode schema.GenerationMode) (any, error) {
+	return map[string]any{}, nil
+}
+
+func (n newSchemaGenerator) GenerateInvalid(schemaPath string, document any) ([]schema.Mutation, error) {
+	return nil, nil
+}
+
+func TestState_SetSchemaStringGenerator(t *testing.T) {
+	s := NewDefaultAPIContext(false, "")
+
+	_, isDefault := s.SchemaGenerators.StringGenerator.(schema.JSONSchemaRawGenerator)
+	if !isDefault {
+		t.Errorf("default StringGenerator is not schema.JSONSchemaRawGenerator")
+	}
+
+	s.SetSchemaStringGenerator(newSchemaGenerator{})
+
+	_, isNewStringGenerator := s.SchemaGenerators.StringGenerator.(newSchemaGenerator)
+	if !isNewStringGenerator {
+		t.Errorf("SetSchemaStringGenerator does not work properly")
+	}
+}
+
+func TestState_SetSchemaReferenceGenerator(t *testing.T) {
+	s := NewDefaultAPIContext(false, "")
+
+	_, isDefault := s.SchemaGenerators.ReferenceGenerator.(schema.JSONSchemaReferenceGenerator)
+	if !isDefault {
+		t.Errorf("default ReferenceGenerator is not schema.JSONSchemaReferenceGenerator")
+	}
+
+	s.SetSchemaReferenceGenerator(newSchemaGenerator{})
+
+	_, isNewReferenceGenerator := s.SchemaGenerators.ReferenceGenerator.(newSchemaGenerator)
+	if !isNewReferenceGenerator {
+		t.Errorf("SetSchemaReferenceGenerator does not work properly")
+	}
+}
+
+func TestState_SetComparisonOptions(t *testing.T) {
+	s := NewDefaultAPIContext(false, "")
+
+	if s.ComparisonOptions.IgnoreArrayOrder || s.ComparisonOptions.NumericTolerance != 0 {
+		t.Errorf("default ComparisonOptions should describe strict comparison")
+	}
+
+	s.SetComparisonOptions(comparator.Options{IgnoreArrayOrder: true, NumericTolerance: 0.5})
+
+	if !s.ComparisonOptions.IgnoreArrayOrder || s.ComparisonOptions.NumericTolerance != 0.5 {
+		t.Errorf("SetComparisonOptions does not work properly")
+	}
+}
+
+func TestState_SetSecurityHeadersPolicy(t *testing.T) {
+	s := NewDefaultAPIContext(false, "")
+
+	if !reflect.DeepEqual(s.SecurityHeadersPolicy, httpctx.DefaultSecurityHeadersPolicy()) {
+		t.Errorf("default SecurityHeadersPolicy should be httpctx.DefaultSecurityHeadersPolicy")
+	}
+
+	s.SetSecurityHeadersPolicy(httpctx.SecurityHeadersPolicy{RequireNoSniff: true})
+
+	if !reflect.DeepEqual(s.SecurityHeadersPolicy, httpctx.SecurityHeadersPolicy{RequireNoSniff: true}) {
+		t.Errorf("SetSecurityHeadersPolicy does not work properly")
+	}
+}
+
+func TestState_SetResponseBodyOptions(t *testing.T) {
+	s := NewDefaultAPIContext(false, "")
+
+	if s.ResponseBodyOptions != (httpctx.ResponseBodyOptions{}) {
+		t.Errorf("default ResponseBodyOptions should not limit response body size")
+	}
+
+	s.SetResponseBodyOptions(httpctx.ResponseBodyOptions{MaxSize: 1024, StreamToFile: true})
+
+	if s.ResponseBodyOptions != (httpctx.ResponseBodyOptions{MaxSize: 1024, StreamToFile: true}) {
+		t.Errorf("SetResponseBodyOptions does not work properly")
+	}
+}
+
+func TestState_SetSoftAssertionMode(t *testing.T) {
+	s := NewDefaultAPIContext(false, "")
+
+	if s.isSoftAssertionMode {
+		t.Errorf("soft assertion mode should be off by default")
+	}
+
+	s.SetSoftAssertionMode(true)
+
+	if !s.isSoftAssertionMode {
+		t.Errorf("SetSoftAssertionMode does not work properly")
+	}
+}
@@ -18,6 +18,11 @@
 //	func (apiCtx *APIContext) SetTemplateEngine(t templateEngine)
 //	func (apiCtx *APIContext) SetSchemaStringValidator(j validator.SchemaValidator)
 //	func (apiCtx *APIContext) SetSchemaReferenceValidator(j validator.SchemaValidator)
+//	func (apiCtx *APIContext) SetSchemaStringGenerator(g schemaGenerator)
+//	func (apiCtx *APIContext) SetSchemaReferenceGenerator(g schemaGenerator)
+//	func (apiCtx *APIContext) SetComparisonOptions(o comparator.Options)
+//	func (apiCtx *APIContext) SetResponseBodyOptions(o httpctx.ResponseBodyOptions)
+//	func (apiCtx *APIContext) SetSoftAssertionMode(isOn bool)
 //	func (apiCtx *APIContext) SetJSONPathFinder(r pathFinder)
 //	func (apiCtx *APIContext) SetJSONSerializer(jf serializable)
 //	func (apiCtx *APIContext) SetXMLPathFinder(r pathFinder)
@@ -43,6 +48,8 @@
 //	func (apiCtx *APIContext) GeneratorRandomSentence(charset string, wordMinLength, wordMaxLength int) func(from, to int, cacheKey string) error
 //	func (apiCtx *APIContext) GetTimeAndTravel(t time.Time, timeDirection timeutils.TimeDirection, timeDuration time.Duration, cacheKey string) error
 //	func (apiCtx *APIContext) GenerateTimeAndTravel(timeDirection timeutils.TimeDirection, timeDuration time.Duration, cacheKey string) error
+//	func (apiCtx *APIContext) GenerateFromSchemaByString(schemaTemplate string, mode schema.GenerationMode, cacheKey string) error
+//	func (apiCtx *APIContext) GenerateFromSchemaByReference(referenceTemplate string, mode schema.GenerationMode, cacheKey string) error
 //
 // * Sending HTTP(s) requests:
 //
@@ -56,17 +63,37 @@
 //	func (apiCtx *APIContext) RequestSetCookies(cacheKey, cookiesTemplate string) error
 //	func (apiCtx *APIContext) RequestSetBody(cacheKey string, bodyTemplate string) error
 //	func (apiCtx *APIContext) RequestSend(cacheKey string) error
+//	func (apiCtx *APIContext) RequestSendCORSPreflight(urlTemplate, originTemplate, method, headersTemplate string) error
+//	func (apiCtx *APIContext) RequestSendIfNoneMatch(cacheKey string) error
+//	func (apiCtx *APIContext) RequestSendIfModifiedSince(cacheKey string) error
+//	func (apiCtx *APIContext) RequestSendPaginatedByLink(cacheKey string, dataFormat format.DataFormat, itemsExprTemplate string, maxPages int, itemsCacheKey string) error
+//	func (apiCtx *APIContext) RequestSendPaginatedByCursor(cacheKey string, dataFormat format.DataFormat, itemsExprTemplate, cursorExprTemplate, cursorParam string, maxPages int, itemsCacheKey string) error
+//	func (apiCtx *APIContext) RequestSendPaginatedByPage(cacheKey string, dataFormat format.DataFormat, itemsExprTemplate, pageParam string, maxPages int, itemsCacheKey string) error
+//	func (apiCtx *APIContext) RequestSendPaginatedByOffset(cacheKey string, dataFormat format.DataFormat, itemsExprTemplate, offsetParam string, maxPages int, itemsCacheKey string) error
+//	func (apiCtx *APIContext) RequestSendConcurrently(cacheKey string, count int) error
+//	func (apiCtx *APIContext) RequestSendWithLoadProfile(cacheKey, profileTemplate, summaryCacheKey string) error
 //
 // * Assertions:
 //
 //	func (apiCtx *APIContext) AssertStatusCodeIs(code int) error
 //	func (apiCtx *APIContext) AssertStatusCodeIsNot(code int) error
+//	func (apiCtx *APIContext) AssertStatusCodeIsOfClass(classTemplate string) error
+//	func (apiCtx *APIContext) AssertStatusCodeIsOneOf(codesTemplate string) error
 //	func (apiCtx *APIContext) AssertResponseFormatIs(dataFormat format.DataFormat) error
 //	func (apiCtx *APIContext) AssertResponseFormatIsNot(dataFormat format.DataFormat) error
+//	func (apiCtx *APIContext) AssertResponseBodyEquals(dataFormat format.DataFormat, expectedTemplate, ignorePathsTemplate string) error
+//	func (apiCtx *APIContext) AssertResponseBodyContains(dataFormat format.DataFormat, expectedSubsetTemplate string) error
+//	func (apiCtx *APIContext) AssertResponseBodyMatchesSnapshot(dataFormat format.DataFormat, snapshotPathTemplate, maskedPathsTemplate string) error
 //	func (apiCtx *APIContext) AssertResponseCookieExists(name string) error
 //	func (apiCtx *APIContext) AssertResponseCookieNotExists(name string) error
 //	func (apiCtx *APIContext) AssertResponseCookieValueIs(name, valueTemplate string) error
 //	func (apiCtx *APIContext) AssertResponseCookieValueNotMatchesRegExp(name, regExpTemplate string) error
+//	func (apiCtx *APIContext) AssertResponseCookieIsSecure(name string) error
+//	func (apiCtx *APIContext) AssertResponseCookieIsHttpOnly(name string) error
+//	func (apiCtx *APIContext) AssertResponseCookieSameSiteIs(name, sameSite string) error
+//	func (apiCtx *APIContext) AssertResponseCookiePathIs(name, pathTemplate string) error
+//	func (apiCtx *APIContext) AssertResponseCookieDomainIs(name, domainTemplate string) error
+//	func (apiCtx *APIContext) AssertResponseCookieExpiresWithin(name string, timeInterval time.Duration) error
 //	func (apiCtx *APIContext) AssertNodesExist(dataFormat format.DataFormat, expressionsTemplates string) error
 //	func (apiCtx *APIContext) AssertNodeExists(dataFormat format.DataFormat, exprTemplate string) error
 //	func (apiCtx *APIContext) AssertNodeNotExists(dataFormat format.DataFormat, exprTemplate string) error
@@ -76,23 +103,78 @@
 //	func (apiCtx *APIContext) AssertNodeNotMatchesRegExp(dataFormat format.DataFormat, exprTemplate, regExpTemplate string) error
 //	func (apiCtx *APIContext) AssertNodeIsTypeAndValue(dataFormat format.DataFormat, exprTemplate string, dataType types.DataType, dataValue string) error
 //	func (apiCtx *APIContext) AssertNodeIsTypeAndHasOneOfValues(dataFormat format.DataFormat, exprTemplate string, dataType types.DataType, valuesTemplates string) error
+//	func (apiCtx *APIContext) AssertNodeEqualsCached(dataFormat format.DataFormat, exprTemplate, cacheKey string) error
+//	func (apiCtx *APIContext) AssertNodeNotEqualsCached(dataFormat format.DataFormat, exprTemplate, cacheKey string) error
+//	func (apiCtx *APIContext) AssertNodeNumberCompare(dataFormat format.DataFormat, exprTemplate string, operator mathutils.Operator, valueTemplate string) error
+//	func (apiCtx *APIContext) AssertNodeTimeIsBefore(dataFormat format.DataFormat, exprTemplate, layout, cacheKey string) error
+//	func (apiCtx *APIContext) AssertNodeTimeIsAfter(dataFormat format.DataFormat, exprTemplate, layout, cacheKey string) error
+//	func (apiCtx *APIContext) AssertNodeTimeIsWithin(dataFormat format.DataFormat, exprTemplate, layout string, timeInterval time.Duration) error
+//	func (apiCtx *APIContext) AssertNodeTimeIsInTimezone(dataFormat format.DataFormat, exprTemplate, layout, timezoneTemplate string) error
 //	func (apiCtx *APIContext) AsserNodeNotContainsSubString(dataFormat format.DataFormat, exprTemplate string, subTemplate string) error
 //	func (apiCtx *APIContext) AsserNodeContainsSubString(dataFormat format.DataFormat, exprTemplate string, subTemplate string) error
 //	func (apiCtx *APIContext) AssertNodeSliceLengthIs(dataFormat format.DataFormat, exprTemplate string, length int) error
 //	func (apiCtx *APIContext) AssertNodeSliceLengthIsNot(dataFormat format.DataFormat, exprTemplate string, length int) error
+//	func (apiCtx *APIContext) AssertNodeElementsHaveValue(dataFormat format.DataFormat, exprTemplate string, quantifier collection.Quantifier, subExprTemplate, valueTemplate string) error
+//	func (apiCtx *APIContext) AssertNodeElementsMatchRegExp(dataFormat format.DataFormat, exprTemplate string, quantifier collection.Quantifier, subExprTemplate, regExpTemplate string) error
+//	func (apiCtx *APIContext) AssertNodeElementsAreType(dataFormat format.DataFormat, exprTemplate string, quantifier collection.Quantifier, subExprTemplate string, dataType types.DataType) error
+//	func (apiCtx *APIContext) AssertNodeElementsAreSorted(dataFormat format.DataFormat, exprTemplate, subExprTemplate string, order collection.Order, comparison collection.Comparison, layout string) error
+//	func (apiCtx *APIContext) AssertNodeElementsAreUnique(dataFormat format.DataFormat, exprTemplate, subExprTemplate string) error
+//	func (apiCtx *APIContext) AssertNodeHasSameElementsAs(dataFormat format.DataFormat, exprTemplate, expectedTemplate string) error
 //	func (apiCtx *APIContext) AssertResponseHeaderExists(name string) error
 //	func (apiCtx *APIContext) AssertResponseHeaderNotExists(name string) error
 //	func (apiCtx *APIContext) AssertResponseHeaderValueIs(name, value string) error
+//	func (apiCtx *APIContext) AssertResponseHeaderValueMatchesRegExp(name, regExpTemplate string) error
+//	func (apiCtx *APIContext) AssertResponseHeaderValueContains(name, subTemplate string) error
+//	func (apiCtx *APIContext) AssertResponseHeaderHasAllValues(name, valuesTemplate string) error
+//	func (apiCtx *APIContext) AssertResponseContentTypeIs(contentTypeTemplate string) error
+//	func (apiCtx *APIContext) AssertResponseSecurityHeaders() error
+//	func (apiCtx *APIContext) AssertResponseCORSAllowsOrigin(originTemplate string) error
+//	func (apiCtx *APIContext) AssertResponseCORSAllowsMethods(methodsTemplate string) error
+//	func (apiCtx *APIContext) AssertResponseCORSAllowsHeaders(headersTemplate string) error
+//	func (apiCtx *APIContext) AssertResponseCORSAllowsCredentials() error
+//	func (apiCtx *APIContext) AssertResponseCORSMaxAgeIsAtLeast(timeInterval time.Duration) error
+//	func (apiCtx *APIContext) AssertResponseCORSVariesByOrigin() error
+//	func (apiCtx *APIContext) AssertResponseIsNotModified() error
+//	func (apiCtx *APIContext) AssertCacheControlDirectiveExists(directiveTemplate string) error
+//	func (apiCtx *APIContext) AssertCacheControlDirectiveNotExists(directiveTemplate string) error
+//	func (apiCtx *APIContext) AssertCacheControlMaxAgeIsBetween(directiveTemplate string, from, to time.Duration) error
+//	func (apiCtx *APIContext) AssertResponseIsProblemDetails(typeTemplate, extensionsTemplate string) error
+//	func (apiCtx *APIContext) AssertJWTIsNotExpired(cacheKey string) error
+//	func (apiCtx *APIContext) AssertJWTTimeClaimIsWithin(cacheKey, claim string, timeInterval time.Duration) error
+//	func (apiCtx *APIContext) AssertJWTSignatureIsValidByHMAC(cacheKey, secretTemplate string) error
+//	func (apiCtx *APIContext) AssertJWTSignatureIsValidByPEM(cacheKey, publicKeyTemplate string) error
+//	func (apiCtx *APIContext) AssertJWTSignatureIsValidByJWKS(cacheKey, pathTemplate string) error
+//	func (apiCtx *APIContext) AssertResponsesStatusCodesAre(countsTemplate string) error
+//	func (apiCtx *APIContext) AssertResponsesStatusCodeCountIs(code, count int) error
+//	func (apiCtx *APIContext) AssertLoadLatencyPercentileIsAtMost(cacheKey string, percentile float64, timeInterval time.Duration) error
+//	func (apiCtx *APIContext) AssertLoadErrorRateIsAtMost(cacheKey string, maxErrorRate float64) error
+//	func (apiCtx *APIContext) AssertLoadThroughputIsAtLeast(cacheKey string, minThroughput float64) error
 //	func (apiCtx *APIContext) AssertResponseMatchesSchemaByReference(referenceTemplate string) error
 //	func (apiCtx *APIContext) AssertResponseMatchesSchemaByString(schemaTemplate string) error
 //	func (apiCtx *APIContext) AssertNodeMatchesSchemaByString(dataFormat format.DataFormat, exprTemplate, schemaTemplate string) error
 //	func (apiCtx *APIContext) AssertNodeMatchesSchemaByReference(dataFormat format.DataFormat, exprTemplate, referenceTemplate string) error
+//	func (apiCtx *APIContext) AssertRequestRejectsSchemaViolationsByString(cacheKey, bodyTemplate, schemaTemplate string, statusCode int) error
+//	func (apiCtx *APIContext) AssertRequestRejectsSchemaViolationsByReference(cacheKey, bodyTemplate, referenceTemplate string, statusCode int) error
 //	func (apiCtx *APIContext) AssertTimeBetweenRequestAndResponseIs(timeInterval time.Duration) error
+//	func (apiCtx *APIContext) AssertResponseTimingPhaseIsAtMost(phase string, timeInterval time.Duration) error
+//	func (apiCtx *APIContext) AssertResponseContentLengthIs(length int) error
+//	func (apiCtx *APIContext) AssertResponseBodySizeIs(size int) error
+//	func (apiCtx *APIContext) AssertResponseBodySizeIsAtMost(size int) error
+//	func (apiCtx *APIContext) AssertAll() error
 //
 // * Preserving nodes:
 //
 //	func (apiCtx *APIContext) SaveNode(dataFormat format.DataFormat, exprTemplate, cacheKey string) error
+//	func (apiCtx *APIContext) SaveNodeTime(dataFormat format.DataFormat, exprTemplate, layout, cacheKey string) error
 //	func (apiCtx *APIContext) SaveHeader(name, cacheKey string) error
+//	func (apiCtx *APIContext) SaveHeaderRegExpMatch(name, regExpTemplate, cacheKey string) error
+//	func (apiCtx *APIContext) SaveCookie(name, cacheKey string) error
+//	func (apiCtx *APIContext) SaveJWTFromNode(dataFormat format.DataFormat, exprTemplate, cacheKey string) error
+//	func (apiCtx *APIContext) SaveJWTFromHeader(name, cacheKey string) error
+//	func (apiCtx *APIContext) SaveJWTFromCache(tokenCacheKey, cacheKey string) error
+//	func (apiCtx *APIContext) SaveJWTPart(cacheKey, part, documentCacheKey string) error
+//	func (apiCtx *APIContext) SetCachedDocumentAsNodeSource(cacheKey string) error
+//	func (apiCtx *APIContext) SetLastResponseAsNodeSource() error
 //	func (apiCtx *APIContext) Save(valueTemplate, cacheKey string) error
 //
 // * Flow control:
@@ -105,6 +187,10 @@
 //	func (apiCtx *APIContext) DebugStart() error
 //	func (apiCtx *APIContext) DebugStop() error
 //
+// Every Assert* method returns failure as *AssertionError, which holds assertion name, its arguments, checked expression
+// and, when known, expected and actual values. It wraps *NodeNotFoundError or *TypeMismatchError when node could not be
+// found or has unexpected type, all of them may be extracted with errors.As.
+//
 // Here is example lib usage to test endpoint returning list of ducks gifs:
 //
 //		ac := gdutils.NewDefaultAPIContext(false, "")
@@ -0,0 +1,112 @@
+package gdutils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pawelWritesCode/df"
+
+	"github.com/pawelWritesCode/gdutils/pkg/types"
+)
+
+var (
+	// ErrEmptyBody represents error when node is searched in empty body.
+	ErrEmptyBody = errors.New("provided nil body")
+
+	// ErrUnsupportedDataFormat represents error when data format is not supported by method.
+	ErrUnsupportedDataFormat = errors.New("unsupported data format")
+
+	// ErrUnsupportedDataType represents error when data type is not supported in given data format.
+	ErrUnsupportedDataType = errors.New("unsupported data type")
+)
+
+// StatusCodeExpression is Expression of AssertionError returned by assertions of HTTP(s) response status code.
+const StatusCodeExpression = "status code"
+
+// AssertionError is error returned by every Assert* method of APIContext when assertion fails.
+// It holds details of failure, which may be extracted with errors.As, and wraps underlying error,
+// for example NodeNotFoundError or TypeMismatchError. Its message is message of underlying error.
+type AssertionError struct {
+	// Assertion is name of APIContext method which failed, for example: AssertStatusCodeIs.
+	Assertion string
+
+	// Arguments are arguments passed to assertion method.
+	Arguments []any
+
+	// Expression is expression of checked node, name of checked header or cookie or StatusCodeExpression,
+	// if assertion checks one of them.
+	Expression string
+
+	// DataFormat is format of checked data, if assertion checks data in particular format.
+	DataFormat df.DataFormat
+
+	// Expected is expected value, if assertion compares values.
+	Expected any
+
+	// Actual is actual value, if assertion compares values and actual value was obtained.
+	Actual any
+
+	// Err is underlying error.
+	Err error
+}
+
+// Error returns message of underlying error.
+func (e *AssertionError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("assertion %s failed, expected: %v, actual: %v", e.Assertion, e.Expected, e.Actual)
+	}
+
+	return e.Err.Error()
+}
+
+// Unwrap returns underlying error.
+func (e *AssertionError) Unwrap() error {
+	return e.Err
+}
+
+// NodeNotFoundError is error returned when node could not be found in data using expression.
+type NodeNotFoundError struct {
+	// Expression is expression used to find node.
+	Expression string
+
+	// DataFormat is format of data in which node was searched.
+	DataFormat df.DataFormat
+
+	// Err is error returned by path finder.
+	Err error
+}
+
+// Error returns message describing missing node.
+func (e *NodeNotFoundError) Error() string {
+	return fmt.Sprintf("could not find node using provided expression: '%s', err: %v", e.Expression, e.Err)
+}
+
+// Unwrap returns error returned by path finder.
+func (e *NodeNotFoundError) Unwrap() error {
+	return e.Err
+}
+
+// TypeMismatchError is error returned when node has different data type than expected.
+type TypeMismatchError struct {
+	// Expression is expression of node.
+	Expression string
+
+	// DataFormat is format of data in which node was found.
+	DataFormat df.DataFormat
+
+	// ExpectedType is expected data type of node.
+	ExpectedType types.DataType
+
+	// ActualType is data type of node in terms of DataFormat.
+	ActualType types.DataType
+
+	// ActualGoType is data type of node in terms of Go.
+	ActualGoType types.DataType
+}
+
+// Error returns message describing both expected and actual data types of node.
+func (e *TypeMismatchError) Error() string {
+	return fmt.Sprintf("expected node '%s' to be '%s', but node value is detected as '%s' in terms of %s and '%s' in terms of Go",
+		e.Expression, e.ExpectedType, e.ActualType, strings.ToUpper(string(e.DataFormat)), e.ActualGoType)
+}
@@ -0,0 +1,125 @@
+package gdutils
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/pawelWritesCode/df"
+
+	"github.com/pawelWritesCode/gdutils/pkg/httpcache"
+	"github.com/pawelWritesCode/gdutils/pkg/types"
+)
+
+func TestAssertionError(t *testing.T) {
+	body := `{"user": {"id": 1, "name": "abc"}}`
+
+	tests := []struct {
+		name           string
+		assert         func(apiCtx *APIContext) error
+		wantAssertion  string
+		wantExpression string
+		wantExpected   any
+		wantActual     any
+		wantNotFound   bool
+		wantMismatch   bool
+	}{
+		{name: "status code", assert: func(apiCtx *APIContext) error { return apiCtx.AssertStatusCodeIs(201) },
+			wantAssertion: "AssertStatusCodeIs", wantExpression: StatusCodeExpression, wantExpected: 201, wantActual: 200},
+		{name: "node value", assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertNodeIsTypeAndValue(df.JSON, "user.name", types.String, "def")
+		}, wantAssertion: "AssertNodeIsTypeAndValue", wantExpression: "user.name", wantExpected: "def", wantActual: "abc"},
+		{name: "missing node", assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertNodeIsTypeAndValue(df.JSON, "user.email", types.String, "def")
+		}, wantAssertion: "AssertNodeIsTypeAndValue", wantExpression: "user.email", wantNotFound: true},
+		{name: "missing node wrapped with additional context", assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertNodeMatchesRegExp(df.JSON, "user.email", ".*")
+		}, wantAssertion: "AssertNodeMatchesRegExp", wantExpression: "user.email", wantNotFound: true},
+		{name: "type mismatch", assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertNodeIsType(df.JSON, "user.id", types.String)
+		}, wantAssertion: "AssertNodeIsType", wantExpression: "user.id", wantExpected: types.String, wantActual: types.Number, wantMismatch: true},
+		{name: "header value", assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertResponseHeaderValueIs("Content-Type", "text/plain")
+		}, wantAssertion: "AssertResponseHeaderValueIs", wantExpression: "Content-Type", wantExpected: "text/plain", wantActual: "application/json"},
+		{name: "format mismatch", assert: func(apiCtx *APIContext) error { return apiCtx.AssertResponseFormatIs(df.XML) },
+			wantAssertion: "AssertResponseFormatIs", wantExpected: df.XML},
+		{name: "error without details", assert: func(apiCtx *APIContext) error { return apiCtx.AssertResponseFormatIs("unknown") },
+			wantAssertion: "AssertResponseFormatIs"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(body)),
+			})
+
+			err := tt.assert(apiCtx)
+
+			var assertionErr *AssertionError
+			if !errors.As(err, &assertionErr) {
+				t.Fatalf("expected AssertionError, got %T: %v", err, err)
+			}
+
+			if assertionErr.Assertion != tt.wantAssertion || assertionErr.Expression != tt.wantExpression {
+				t.Errorf("got assertion %s with expression %s, want %s with %s", assertionErr.Assertion, assertionErr.Expression, tt.wantAssertion, tt.wantExpression)
+			}
+
+			if assertionErr.Expected != tt.wantExpected || assertionErr.Actual != tt.wantActual {
+				t.Errorf("got expected %#v and actual %#v, want %#v and %#v", assertionErr.Expected, assertionErr.Actual, tt.wantExpected, tt.wantActual)
+			}
+
+			if assertionErr.Error() != errors.Unwrap(err).Error() {
+				t.Errorf("AssertionError message %s should be message of underlying error", assertionErr.Error())
+			}
+
+			var notFoundErr *NodeNotFoundError
+			if errors.As(err, &notFoundErr) != tt.wantNotFound {
+				t.Errorf("errors.As(NodeNotFoundError) = %v, want %v", !tt.wantNotFound, tt.wantNotFound)
+			}
+
+			var mismatchErr *TypeMismatchError
+			if errors.As(err, &mismatchErr) != tt.wantMismatch {
+				t.Errorf("errors.As(TypeMismatchError) = %v, want %v", !tt.wantMismatch, tt.wantMismatch)
+			}
+		})
+	}
+}
+
+func TestAPIContext_getNodeErrors(t *testing.T) {
+	apiCtx := NewDefaultAPIContext(false, "")
+	body := []byte(`{"id": 1}`)
+
+	_, err := apiCtx.getNode(body, "name", df.JSON, types.Any)
+	var notFoundErr *NodeNotFoundError
+	if !errors.As(err, &notFoundErr) || notFoundErr.Expression != "name" || notFoundErr.DataFormat != df.JSON {
+		t.Errorf("getNode() error = %#v, want NodeNotFoundError", err)
+	}
+
+	_, err = apiCtx.getNode(body, "id", df.JSON, types.String)
+	var mismatchErr *TypeMismatchError
+	if !errors.As(err, &mismatchErr) || mismatchErr.ExpectedType != types.String || mismatchErr.ActualType != types.Number {
+		t.Errorf("getNode() error = %#v, want TypeMismatchError", err)
+	}
+
+	want := "expected node 'id' to be 'string', but node value is detected as 'number' in terms of JSON and 'int' in terms of Go"
+	if err.Error() != want {
+		t.Errorf("getNode() error = %s, want %s", err, want)
+	}
+
+	_, err = apiCtx.getNode(nil, "id", df.JSON, types.Any)
+	if !errors.As(err, &notFoundErr) || !errors.Is(err, ErrEmptyBody) || notFoundErr.Expression != "id" {
+		t.Errorf("getNode() error = %#v, want NodeNotFoundError wrapping ErrEmptyBody", err)
+	}
+
+	if _, err = apiCtx.getNode(body, "id", "csv", types.Any); !errors.Is(err, ErrUnsupportedDataFormat) {
+		t.Errorf("getNode() error = %v, want ErrUnsupportedDataFormat", err)
+	}
+
+	if _, err = apiCtx.getNode(body, "id", df.JSON, "complex"); !errors.Is(err, ErrUnsupportedDataType) {
+		t.Errorf("getNode() error = %v, want ErrUnsupportedDataType", err)
+	}
+}
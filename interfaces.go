@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/pawelWritesCode/gdutils/pkg/osutils"
+	"github.com/pawelWritesCode/gdutils/pkg/schema"
 	"github.com/pawelWritesCode/gdutils/pkg/types"
 )
 
@@ -22,6 +23,12 @@ type cacheable interface {
 	All() map[string]any
 }
 
+// bytesLimiter is optional behaviour of debugger, which limits number of bytes it prints.
+type bytesLimiter interface {
+	// Limit returns the maximum number of bytes to be printed.
+	Limit() uint16
+}
+
 // debuggable defines desired debugger behaviour.
 type debuggable interface {
 	// Print prints provided info.
@@ -77,3 +84,12 @@ type typeMapper interface {
 	// Map maps data type.
 	Map(data any) types.DataType
 }
+
+// schemaGenerator describes ability to generate data valid against some kind of schema.
+type schemaGenerator interface {
+	// Generate generates data valid against schema located in schemaPath. mode decides which of allowed values are picked.
+	Generate(schemaPath string, mode schema.GenerationMode) (any, error)
+
+	// GenerateInvalid generates variants of valid document, each of them violating schema located in schemaPath.
+	GenerateInvalid(schemaPath string, document any) ([]schema.Mutation, error)
+}
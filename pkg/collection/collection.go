@@ -0,0 +1,54 @@
+// Package collection holds utilities for working with collections of elements.
+package collection
+
+import "fmt"
+
+// Quantifier describes how many elements of collection should satisfy condition.
+type Quantifier string
+
+const (
+	// QuantifierEvery means that every element of collection should satisfy condition.
+	QuantifierEvery Quantifier = "every"
+
+	// QuantifierAny means that at least one element of collection should satisfy condition.
+	QuantifierAny Quantifier = "any"
+
+	// QuantifierNone means that no element of collection should satisfy condition.
+	QuantifierNone Quantifier = "none"
+)
+
+// Evaluate checks whether elements satisfy quantifier. matched tells whether element under given index satisfies condition.
+// Returned indices point at elements that break quantifier: not matching elements for QuantifierEvery,
+// matching elements for QuantifierNone and nothing for QuantifierAny.
+func Evaluate(quantifier Quantifier, matched []bool) (bool, []int, error) {
+	offending := make([]int, 0)
+
+	switch quantifier {
+	case QuantifierEvery:
+		for i, isMatched := range matched {
+			if !isMatched {
+				offending = append(offending, i)
+			}
+		}
+
+		return len(offending) == 0, offending, nil
+	case QuantifierNone:
+		for i, isMatched := range matched {
+			if isMatched {
+				offending = append(offending, i)
+			}
+		}
+
+		return len(offending) == 0, offending, nil
+	case QuantifierAny:
+		for _, isMatched := range matched {
+			if isMatched {
+				return true, offending, nil
+			}
+		}
+
+		return false, offending, nil
+	default:
+		return false, nil, fmt.Errorf("unknown quantifier: %s, allowed: %s, %s, %s", quantifier, QuantifierEvery, QuantifierAny, QuantifierNone)
+	}
+}
@@ -0,0 +1,43 @@
+package collection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name          string
+		quantifier    Quantifier
+		matched       []bool
+		want          bool
+		wantOffending []int
+		wantErr       bool
+	}{
+		{name: "unknown quantifier", quantifier: "most", matched: []bool{true}, wantErr: true},
+		{name: "every - all matched", quantifier: QuantifierEvery, matched: []bool{true, true}, want: true, wantOffending: []int{}},
+		{name: "every - some not matched", quantifier: QuantifierEvery, matched: []bool{true, false, true, false}, want: false, wantOffending: []int{1, 3}},
+		{name: "every - empty collection", quantifier: QuantifierEvery, matched: []bool{}, want: true, wantOffending: []int{}},
+		{name: "any - one matched", quantifier: QuantifierAny, matched: []bool{false, true}, want: true, wantOffending: []int{}},
+		{name: "any - none matched", quantifier: QuantifierAny, matched: []bool{false, false}, want: false, wantOffending: []int{}},
+		{name: "any - empty collection", quantifier: QuantifierAny, matched: []bool{}, want: false, wantOffending: []int{}},
+		{name: "none - none matched", quantifier: QuantifierNone, matched: []bool{false, false}, want: true, wantOffending: []int{}},
+		{name: "none - some matched", quantifier: QuantifierNone, matched: []bool{true, false, true}, want: false, wantOffending: []int{0, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, offending, err := Evaluate(tt.quantifier, tt.matched)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Evaluate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got != tt.want || !reflect.DeepEqual(offending, tt.wantOffending) {
+				t.Errorf("Evaluate() got = %v, %v, want %v, %v", got, offending, tt.want, tt.wantOffending)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package collection
+
+import (
+	"fmt"
+	"time"
+)
+
+// Order describes expected order of collection elements.
+type Order string
+
+const (
+	// OrderAscending means that every element of collection should not be lower than previous one.
+	OrderAscending Order = "asc"
+
+	// OrderDescending means that every element of collection should not be greater than previous one.
+	OrderDescending Order = "desc"
+)
+
+// Comparison describes how elements of collection are compared with each other.
+type Comparison string
+
+const (
+	// ComparisonString means that elements are compared lexicographically as strings.
+	ComparisonString Comparison = "string"
+
+	// ComparisonNumber means that elements are compared as numbers.
+	ComparisonNumber Comparison = "number"
+
+	// ComparisonTime means that elements are compared as points in time.
+	ComparisonTime Comparison = "time"
+)
+
+// FirstUnsorted returns index of first element breaking order or -1, when elements are sorted.
+// Elements should be all string, all float64 or all time.Time values.
+func FirstUnsorted(elements []any, order Order) (int, error) {
+	if order != OrderAscending && order != OrderDescending {
+		return -1, fmt.Errorf("unknown order: %s, allowed: %s, %s", order, OrderAscending, OrderDescending)
+	}
+
+	for i := 1; i < len(elements); i++ {
+		result, err := compare(elements[i-1], elements[i])
+		if err != nil {
+			return -1, err
+		}
+
+		if order == OrderAscending && result > 0 || order == OrderDescending && result < 0 {
+			return i, nil
+		}
+	}
+
+	return -1, nil
+}
+
+// compare returns negative number when a is lower than b, positive number when a is greater than b and 0 otherwise.
+func compare(a, b any) (int, error) {
+	switch aVal := a.(type) {
+	case string:
+		if bVal, ok := b.(string); ok {
+			switch {
+			case aVal < bVal:
+				return -1, nil
+			case aVal > bVal:
+				return 1, nil
+			default:
+				return 0, nil
+			}
+		}
+	case float64:
+		if bVal, ok := b.(float64); ok {
+			switch {
+			case aVal < bVal:
+				return -1, nil
+			case aVal > bVal:
+				return 1, nil
+			default:
+				return 0, nil
+			}
+		}
+	case time.Time:
+		if bVal, ok := b.(time.Time); ok {
+			switch {
+			case aVal.Before(bVal):
+				return -1, nil
+			case aVal.After(bVal):
+				return 1, nil
+			default:
+				return 0, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("could not compare values %v (%T) and %v (%T)", a, a, b, b)
+}
+
+// Duplicates returns indices of elements having equal keys, grouped by key in order of first occurrence.
+// Keys holding only one element are omitted.
+func Duplicates(keys []string) [][]int {
+	groups := make(map[string][]int, len(keys))
+	order := make([]string, 0, len(keys))
+	for i, key := range keys {
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+
+		groups[key] = append(groups[key], i)
+	}
+
+	duplicates := make([][]int, 0)
+	for _, key := range order {
+		if len(groups[key]) > 1 {
+			duplicates = append(duplicates, groups[key])
+		}
+	}
+
+	return duplicates
+}
@@ -0,0 +1,61 @@
+package collection
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFirstUnsorted(t *testing.T) {
+	day := time.Date(2022, 3, 4, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		elements []any
+		order    Order
+		want     int
+		wantErr  bool
+	}{
+		{name: "unknown order", elements: []any{1.0}, order: "random", want: -1, wantErr: true},
+		{name: "empty collection", elements: []any{}, order: OrderAscending, want: -1},
+		{name: "ascending numbers", elements: []any{1.0, 2.0, 2.0, 10.0}, order: OrderAscending, want: -1},
+		{name: "ascending numbers - unsorted", elements: []any{1.0, 10.0, 2.0}, order: OrderAscending, want: 2},
+		{name: "descending strings", elements: []any{"c", "b", "b", "a"}, order: OrderDescending, want: -1},
+		{name: "descending strings - unsorted", elements: []any{"b", "c"}, order: OrderDescending, want: 1},
+		{name: "ascending strings are compared lexicographically", elements: []any{"10", "2"}, order: OrderAscending, want: -1},
+		{name: "ascending times", elements: []any{day, day.Add(time.Hour)}, order: OrderAscending, want: -1},
+		{name: "descending times - unsorted", elements: []any{day, day.Add(time.Hour)}, order: OrderDescending, want: 1},
+		{name: "mixed types", elements: []any{1.0, "a"}, order: OrderAscending, want: -1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FirstUnsorted(tt.elements, tt.order)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FirstUnsorted() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("FirstUnsorted() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDuplicates(t *testing.T) {
+	tests := []struct {
+		name string
+		keys []string
+		want [][]int
+	}{
+		{name: "no keys", keys: []string{}, want: [][]int{}},
+		{name: "unique keys", keys: []string{"a", "b", "c"}, want: [][]int{}},
+		{name: "duplicated keys", keys: []string{"b", "a", "b", "c", "a", "b"}, want: [][]int{{0, 2, 5}, {1, 4}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Duplicates(tt.keys); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Duplicates() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
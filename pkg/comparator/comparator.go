@@ -0,0 +1,492 @@
+package comparator
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// PlaceholderAnyString matches any string.
+	PlaceholderAnyString = "<any string>"
+
+	// PlaceholderUUID matches string holding UUID.
+	PlaceholderUUID = "<uuid>"
+
+	// PlaceholderNumber matches any number.
+	PlaceholderNumber = "<number>"
+
+	// placeholderRegExpPrefix starts placeholder matching scalar values against regular expression, for example: <regexp:^[a-z]+$>
+	placeholderRegExpPrefix = "<regexp:"
+)
+
+var uuidRegExp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// Options describes how documents should be compared.
+type Options struct {
+	// IgnorePaths holds paths of nodes that are skipped during comparison, for example: $.user.id, $.items[*].createdAt
+	// Notation [*] matches any array index and .* matches any object key.
+	IgnorePaths []string
+
+	// IgnoreArrayOrder tells whether arrays with the same elements in different order are equal.
+	IgnoreArrayOrder bool
+
+	// NumericTolerance is maximum allowed absolute difference between compared numbers.
+	NumericTolerance float64
+
+	// Subset tells whether actual document may contain nodes not present in expected document.
+	// In this mode elements of expected arrays are searched in actual arrays regardless of their order.
+	Subset bool
+
+	// Placeholders tells whether string values of expected document may be one of placeholders:
+	// "<any string>", "<uuid>", "<number>" or "<regexp:pattern>", which match any actual value of given kind.
+	Placeholders bool
+
+	// ScalarsAsStrings tells whether documents hold all scalar values as strings, like documents decoded from XML.
+	// In this mode placeholder "<number>" matches also strings holding numbers.
+	ScalarsAsStrings bool
+}
+
+// Difference describes single difference between expected and actual document.
+type Difference struct {
+	// Path is location of node, for example: $.users[1].name
+	Path string
+
+	// Message describes difference.
+	Message string
+}
+
+// String returns difference in human readable form.
+func (d Difference) String() string {
+	return fmt.Sprintf("%s: %s", d.Path, d.Message)
+}
+
+// Compare structurally compares expected and actual documents produced by Decode and returns all found differences.
+func Compare(expected, actual any, options Options) ([]Difference, error) {
+	ignored := make([][]string, 0, len(options.IgnorePaths))
+	for _, path := range options.IgnorePaths {
+		tokens, err := parsePath(path)
+		if err != nil {
+			return nil, err
+		}
+
+		ignored = append(ignored, tokens)
+	}
+
+	c := comparison{options: options, ignored: ignored}
+	c.compare(nil, expected, actual)
+
+	return c.differences, nil
+}
+
+// FormatDifferences returns differences in human readable form, one per line.
+func FormatDifferences(differences []Difference) string {
+	lines := make([]string, 0, len(differences))
+	for _, difference := range differences {
+		lines = append(lines, difference.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// comparison holds state of single comparison.
+type comparison struct {
+	options     Options
+	ignored     [][]string
+	differences []Difference
+}
+
+func (c *comparison) compare(path []string, expected, actual any) {
+	if c.isIgnored(path) {
+		return
+	}
+
+	if placeholder, ok := expected.(string); ok && c.options.Placeholders && isPlaceholder(placeholder) {
+		if err := c.matchPlaceholder(placeholder, actual); err != nil {
+			c.add(path, err.Error())
+		}
+
+		return
+	}
+
+	// XML element repeated in actual document is decoded as array, even if expected fragment holds only one of them
+	if act, isArray := actual.([]any); isArray && c.options.Subset && c.options.ScalarsAsStrings {
+		if _, isExpArray := expected.([]any); !isExpArray {
+			c.compareArraysInAnyOrder(path, []any{expected}, act)
+			return
+		}
+	}
+
+	switch exp := expected.(type) {
+	case map[string]any:
+		act, ok := actual.(map[string]any)
+		if !ok {
+			c.typeMismatch(path, expected, actual)
+			return
+		}
+
+		c.compareObjects(path, exp, act)
+	case []any:
+		act, ok := actual.([]any)
+		if !ok {
+			c.typeMismatch(path, expected, actual)
+			return
+		}
+
+		if c.options.IgnoreArrayOrder || c.options.Subset {
+			c.compareArraysInAnyOrder(path, exp, act)
+		} else {
+			c.compareArrays(path, exp, act)
+		}
+	default:
+		if !c.equalScalars(expected, actual) {
+			c.add(path, fmt.Sprintf("expected %s, got %s", describe(expected), describe(actual)))
+		}
+	}
+}
+
+func (c *comparison) compareObjects(path []string, expected, actual map[string]any) {
+	for _, key := range sortedKeys(expected) {
+		keyPath := appendToken(path, "."+key)
+		act, ok := actual[key]
+		if !ok {
+			if !c.isIgnored(keyPath) {
+				c.add(keyPath, fmt.Sprintf("expected %s, but node is missing", describe(expected[key])))
+			}
+
+			continue
+		}
+
+		c.compare(keyPath, expected[key], act)
+	}
+
+	for _, key := range sortedKeys(actual) {
+		keyPath := appendToken(path, "."+key)
+		if _, ok := expected[key]; !ok && !c.options.Subset && !c.isIgnored(keyPath) {
+			c.add(keyPath, fmt.Sprintf("unexpected node with value %s", describe(actual[key])))
+		}
+	}
+}
+
+func (c *comparison) compareArrays(path []string, expected, actual []any) {
+	for i := 0; i < len(expected) || i < len(actual); i++ {
+		indexPath := appendToken(path, fmt.Sprintf("[%d]", i))
+		switch {
+		case i >= len(actual):
+			if !c.isIgnored(indexPath) {
+				c.add(indexPath, fmt.Sprintf("expected %s, but array element is missing", describe(expected[i])))
+			}
+		case i >= len(expected):
+			if !c.isIgnored(indexPath) {
+				c.add(indexPath, fmt.Sprintf("unexpected array element %s", describe(actual[i])))
+			}
+		default:
+			c.compare(indexPath, expected[i], actual[i])
+		}
+	}
+}
+
+// compareArraysInAnyOrder pairs expected elements with equal actual elements using maximum bipartite matching,
+// so expected element equal to many actual elements, for example placeholder, does not take actual element
+// needed by other expected element. Unpaired elements are reported after matching.
+func (c *comparison) compareArraysInAnyOrder(path []string, expected, actual []any) {
+	matches := make([][]int, len(expected))
+	for i, exp := range expected {
+		indexPath := appendToken(path, fmt.Sprintf("[%d]", i))
+		if c.isIgnored(indexPath) {
+			continue
+		}
+
+		for j, act := range actual {
+			sub := comparison{options: c.options, ignored: c.ignored}
+			sub.compare(indexPath, exp, act)
+			if len(sub.differences) == 0 {
+				matches[i] = append(matches[i], j)
+			}
+		}
+	}
+
+	// pairedWith holds index of expected element paired with actual element or -1
+	pairedWith := make([]int, len(actual))
+	for j := range pairedWith {
+		pairedWith[j] = -1
+	}
+
+	var augment func(i int, visited []bool) bool
+	augment = func(i int, visited []bool) bool {
+		for _, j := range matches[i] {
+			if visited[j] {
+				continue
+			}
+
+			visited[j] = true
+			if pairedWith[j] == -1 || augment(pairedWith[j], visited) {
+				pairedWith[j] = i
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for i := range expected {
+		augment(i, make([]bool, len(actual)))
+	}
+
+	paired := make([]bool, len(expected))
+	for _, i := range pairedWith {
+		if i != -1 {
+			paired[i] = true
+		}
+	}
+
+	for i, exp := range expected {
+		indexPath := appendToken(path, fmt.Sprintf("[%d]", i))
+		if !paired[i] && !c.isIgnored(indexPath) {
+			c.add(indexPath, fmt.Sprintf("expected %s, but there is no such array element", describe(exp)))
+		}
+	}
+
+	for j, act := range actual {
+		if pairedWith[j] == -1 && !c.options.Subset && !c.isIgnored(appendToken(path, fmt.Sprintf("[%d]", j))) {
+			c.add(appendToken(path, fmt.Sprintf("[%d]", j)), fmt.Sprintf("unexpected array element %s", describe(act)))
+		}
+	}
+}
+
+// equalScalars compares scalar values, numbers are compared with respect to numeric tolerance.
+// Strings holding numbers (for example XML nodes) are compared as numbers only when numeric tolerance is set.
+func (c *comparison) equalScalars(expected, actual any) bool {
+	if reflect.DeepEqual(expected, actual) {
+		return true
+	}
+
+	_, isExpString := expected.(string)
+	_, isActString := actual.(string)
+	if isExpString != isActString || isExpString && c.options.NumericTolerance == 0 {
+		return false
+	}
+
+	expNumber, isExpNumber := toNumber(expected)
+	actNumber, isActNumber := toNumber(actual)
+	if !isExpNumber || !isActNumber {
+		return false
+	}
+
+	return math.Abs(expNumber-actNumber) <= c.options.NumericTolerance
+}
+
+// matchPlaceholder checks whether actual value matches placeholder.
+func (c *comparison) matchPlaceholder(placeholder string, actual any) error {
+	str, isString := actual.(string)
+
+	switch {
+	case placeholder == PlaceholderAnyString:
+		if isString {
+			return nil
+		}
+	case placeholder == PlaceholderUUID:
+		if isString && uuidRegExp.MatchString(str) {
+			return nil
+		}
+	case placeholder == PlaceholderNumber:
+		if _, isNumber := toNumber(actual); isNumber && (!isString || c.options.ScalarsAsStrings) {
+			return nil
+		}
+	default:
+		pattern := strings.TrimSuffix(strings.TrimPrefix(placeholder, placeholderRegExpPrefix), ">")
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("placeholder %s holds invalid regular expression, err: %w", placeholder, err)
+		}
+
+		if scalar, isScalar := scalarToString(actual); isScalar && re.MatchString(scalar) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("expected value matching placeholder %s, got %s", placeholder, describe(actual))
+}
+
+func (c *comparison) typeMismatch(path []string, expected, actual any) {
+	c.add(path, fmt.Sprintf("expected %s, got %s", describe(expected), describe(actual)))
+}
+
+func (c *comparison) add(path []string, message string) {
+	c.differences = append(c.differences, Difference{Path: "$" + strings.Join(path, ""), Message: message})
+}
+
+// isIgnored tells whether node under path matches any of ignored paths.
+func (c *comparison) isIgnored(path []string) bool {
+	for _, pattern := range c.ignored {
+		if matchesPath(pattern, path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchesPath(pattern, path []string) bool {
+	if len(pattern) != len(path) {
+		return false
+	}
+
+	for i, token := range pattern {
+		switch {
+		case token == path[i]:
+		case token == "[*]" && strings.HasPrefix(path[i], "["):
+		case token == ".*" && strings.HasPrefix(path[i], "."):
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// parsePath splits path like $.users[0].name into tokens: .users, [0], .name
+func parsePath(path string) ([]string, error) {
+	path = strings.TrimSpace(path)
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("path '%s' should start with $", path)
+	}
+
+	tokens := make([]string, 0)
+	rest := path[1:]
+	for len(rest) > 0 {
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end == -1 {
+				end = len(rest) - 1
+			}
+
+			if end == 0 {
+				return nil, fmt.Errorf("path '%s' contains empty key", path)
+			}
+
+			tokens = append(tokens, rest[:end+1])
+			rest = rest[end+1:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("path '%s' contains not closed bracket", path)
+			}
+
+			index := rest[1:end]
+			if _, err := strconv.Atoi(index); err != nil && index != "*" {
+				return nil, fmt.Errorf("path '%s' contains invalid array index '%s'", path, index)
+			}
+
+			tokens = append(tokens, rest[:end+1])
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("path '%s' has invalid syntax near '%s'", path, rest)
+		}
+	}
+
+	return tokens, nil
+}
+
+// isPlaceholder tells whether value is one of supported placeholders.
+func isPlaceholder(value string) bool {
+	switch value {
+	case PlaceholderAnyString, PlaceholderUUID, PlaceholderNumber:
+		return true
+	default:
+		return strings.HasPrefix(value, placeholderRegExpPrefix) && strings.HasSuffix(value, ">")
+	}
+}
+
+// scalarToString returns string representation of scalar value.
+func scalarToString(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case int:
+		return strconv.Itoa(v), true
+	default:
+		return "", false
+	}
+}
+
+// toNumber converts number or string holding number into float64.
+func toNumber(value any) (float64, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// describe returns value with its type in human readable form.
+func describe(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case map[string]any:
+		return "object " + marshal(value)
+	case []any:
+		return "array " + marshal(value)
+	case json.Number, float64, int:
+		return "number " + marshal(value)
+	case string:
+		return "string " + marshal(value)
+	case bool:
+		return "boolean " + marshal(value)
+	default:
+		return fmt.Sprintf("%T %v", value, value)
+	}
+}
+
+// marshal returns compact JSON representation of value, shortened if too long.
+func marshal(value any) string {
+	const maxLength = 120
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	if len(data) > maxLength {
+		return string(data[:maxLength]) + "..."
+	}
+
+	return string(data)
+}
+
+func appendToken(path []string, token string) []string {
+	return append(append(make([]string, 0, len(path)+1), path...), token)
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
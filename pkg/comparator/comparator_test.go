@@ -0,0 +1,219 @@
+package comparator
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/pawelWritesCode/df"
+)
+
+func TestDecode(t *testing.T) {
+	want := map[string]any{"user": map[string]any{"name": "abc", "tags": []any{"a", "b"}}}
+
+	tests := []struct {
+		name       string
+		data       string
+		dataFormat df.DataFormat
+		want       any
+		wantErr    bool
+	}{
+		{name: "json", data: `{"user": {"name": "abc", "tags": ["a", "b"]}}`, dataFormat: df.JSON, want: want},
+		{name: "yaml", data: "user:\n  name: abc\n  tags:\n  - a\n  - b\n", dataFormat: df.YAML, want: want},
+		{name: "xml", data: `<?xml version="1.0"?><user><name>abc</name><tags>a</tags><tags>b</tags></user>`, dataFormat: df.XML, want: want},
+		{name: "xml with attributes and text", data: `<price currency="USD">10<!-- comment --></price>`, dataFormat: df.XML,
+			want: map[string]any{"price": map[string]any{"-currency": "USD", "#text": "10"}}},
+		{name: "invalid json", data: `{"a": `, dataFormat: df.JSON, wantErr: true},
+		{name: "json with trailing data", data: `{"a": 1} {}`, dataFormat: df.JSON, wantErr: true},
+		{name: "invalid xml", data: `<a><b></a>`, dataFormat: df.XML, wantErr: true},
+		{name: "unsupported format", data: `abc`, dataFormat: df.PlainText, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Decode([]byte(tt.data), tt.dataFormat)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Decode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Decode() got = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+		actual   string
+		options  Options
+		want     []string
+		wantErr  bool
+	}{
+		{name: "equal documents with different keys order", expected: `{"a": 1, "b": [1, 2]}`, actual: `{"b": [1, 2], "a": 1.0}`},
+		{name: "different value", expected: `{"a": {"b": "x"}}`, actual: `{"a": {"b": "y"}}`,
+			want: []string{`$.a.b: expected string "x", got string "y"`}},
+		{name: "different type", expected: `{"a": 1}`, actual: `{"a": "1"}`,
+			want: []string{`$.a: expected number 1, got string "1"`}},
+		{name: "missing and unexpected nodes", expected: `{"a": 1, "b": 2}`, actual: `{"a": 1, "c": 3}`,
+			want: []string{`$.b: expected number 2, but node is missing`, `$.c: unexpected node with value number 3`}},
+		{name: "different array order", expected: `[1, 2, 3]`, actual: `[3, 2, 1]`,
+			want: []string{`$[0]: expected number 1, got number 3`, `$[2]: expected number 3, got number 1`}},
+		{name: "different array order - ignored", expected: `[{"a": 1}, {"a": 2}, {"a": 2}]`, actual: `[{"a": 2}, {"a": 1}, {"a": 2}]`,
+			options: Options{IgnoreArrayOrder: true}},
+		{name: "different array elements - order ignored", expected: `[1, 2, 2]`, actual: `[2, 1, 3, 4]`, options: Options{IgnoreArrayOrder: true},
+			want: []string{`$[2]: expected number 2, but there is no such array element`, `$[2]: unexpected array element number 3`, `$[3]: unexpected array element number 4`}},
+		{name: "array length", expected: `[1, 2]`, actual: `[1]`,
+			want: []string{`$[1]: expected number 2, but array element is missing`}},
+		{name: "numbers within tolerance", expected: `{"a": 1.5}`, actual: `{"a": 1.52}`, options: Options{NumericTolerance: 0.05}},
+		{name: "numbers outside tolerance", expected: `{"a": 1.5}`, actual: `{"a": 1.6}`, options: Options{NumericTolerance: 0.05},
+			want: []string{`$.a: expected number 1.5, got number 1.6`}},
+		{name: "ignored paths", expected: `{"id": 1, "items": [{"id": 1, "name": "a"}], "meta": {"x": 1}}`, actual: `{"id": 2, "items": [{"id": 3, "name": "a"}], "meta": {"y": 2}}`,
+			options: Options{IgnorePaths: []string{"$.id", "$.items[*].id", "$.meta.*"}}},
+		{name: "invalid ignored path", expected: `{}`, actual: `{}`, options: Options{IgnorePaths: []string{"id"}}, wantErr: true},
+		{name: "invalid ignored path index", expected: `{}`, actual: `{}`, options: Options{IgnorePaths: []string{"$.items[a]"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected, err := Decode([]byte(tt.expected), df.JSON)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actual, err := Decode([]byte(tt.actual), df.JSON)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			differences, err := Compare(expected, actual, tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Compare() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			got := make([]string, 0, len(differences))
+			for _, difference := range differences {
+				got = append(got, difference.String())
+			}
+
+			if len(got) != len(tt.want) || len(got) > 0 && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Compare() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompare_xmlNumbers(t *testing.T) {
+	expected, _ := Decode([]byte(`<a><b>1.50</b></a>`), df.XML)
+	actual, _ := Decode([]byte(`<a><b>1.5</b></a>`), df.XML)
+
+	if differences, _ := Compare(expected, actual, Options{}); len(differences) != 1 {
+		t.Errorf("without numeric tolerance XML nodes should be compared as strings, got: %v", differences)
+	}
+
+	if differences, _ := Compare(expected, actual, Options{NumericTolerance: 0.001}); len(differences) != 0 {
+		t.Errorf("with numeric tolerance XML nodes should be compared as numbers, got: %v", differences)
+	}
+}
+
+func TestCompare_subsetWithPlaceholders(t *testing.T) {
+	actual := `{"id": "7b1d5d57-8d1d-4c35-9d2c-6c8a3c5e1f10", "name": "abc", "age": 30, "code": "X-12", "active": true, "tags": ["a", "b", "c"], "extra": {"x": 1}}`
+
+	tests := []struct {
+		name     string
+		expected string
+		want     []string
+	}{
+		{name: "empty fragment", expected: `{}`},
+		{name: "fragment with placeholders", expected: `{"id": "<uuid>", "name": "<any string>", "age": "<number>", "code": "<regexp:^X-\\d+$>", "active": "<regexp:true>", "tags": ["c", "a"]}`},
+		{name: "nested fragment", expected: `{"extra": {}}`},
+		{name: "not matching placeholders", expected: `{"id": "<number>", "age": "<any string>", "name": "<uuid>", "code": "<regexp:^Y>"}`,
+			want: []string{
+				`$.age: expected value matching placeholder <any string>, got number 30`,
+				`$.code: expected value matching placeholder <regexp:^Y>, got string "X-12"`,
+				`$.id: expected value matching placeholder <number>, got string "7b1d5d57-8d1d-4c35-9d2c-6c8a3c5e1f10"`,
+				`$.name: expected value matching placeholder <uuid>, got string "abc"`,
+			}},
+		{name: "invalid regexp placeholder", expected: `{"code": "<regexp:(>"}`,
+			want: []string{"$.code: placeholder <regexp:(> holds invalid regular expression, err: error parsing regexp: missing closing ): `(`"}},
+		{name: "missing node and array element", expected: `{"tags": ["d"], "missing": 1}`,
+			want: []string{`$.missing: expected number 1, but node is missing`, `$.tags[0]: expected string "d", but there is no such array element`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected, err := Decode([]byte(tt.expected), df.JSON)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			act, _ := Decode([]byte(actual), df.JSON)
+			differences, _ := Compare(expected, act, Options{Subset: true, Placeholders: true})
+
+			got := make([]string, 0, len(differences))
+			for _, difference := range differences {
+				got = append(got, difference.String())
+			}
+
+			if len(got) != len(tt.want) || len(got) > 0 && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Compare() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompare_arrayInAnyOrderMatching(t *testing.T) {
+	expected, _ := Decode([]byte(`["<any string>", "a"]`), df.JSON)
+	actual, _ := Decode([]byte(`["a", "b"]`), df.JSON)
+
+	for _, options := range []Options{{IgnoreArrayOrder: true, Placeholders: true}, {Subset: true, Placeholders: true}} {
+		if differences, _ := Compare(expected, actual, options); len(differences) != 0 {
+			t.Errorf("placeholder should be paired with element not needed by literal, options: %+v, got: %v", options, differences)
+		}
+	}
+
+	expected, _ = Decode([]byte(`["<any string>", "<any string>", "a"]`), df.JSON)
+	differences, _ := Compare(expected, actual, Options{IgnoreArrayOrder: true, Placeholders: true})
+	if len(differences) != 1 {
+		t.Errorf("Compare() got = %v, want one unpaired element", differences)
+	}
+}
+
+func TestCompare_xmlSubset(t *testing.T) {
+	expected, _ := Decode([]byte(`<user><age>&lt;number&gt;</age><tag>b</tag></user>`), df.XML)
+	actual, _ := Decode([]byte(`<user id="1"><name>abc</name><age>30</age><tag>a</tag><tag>b</tag></user>`), df.XML)
+
+	if differences, _ := Compare(expected, actual, Options{Subset: true, Placeholders: true, ScalarsAsStrings: true}); len(differences) != 0 {
+		t.Errorf("XML fragment should be found in actual document, got: %v", differences)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		want    any
+		wantErr bool
+	}{
+		{name: "numbers of different types", value: []any{1, int64(2), uint64(3), 4.5, float32(1)},
+			want: []any{json.Number("1"), json.Number("2"), json.Number("3"), json.Number("4.5"), json.Number("1")}},
+		{name: "yaml mapping", value: map[any]any{"a": map[any]any{1: true}, "b": nil},
+			want: map[string]any{"a": map[string]any{"1": true}, "b": nil}},
+		{name: "yaml mapping nested in string keyed map", value: map[string]any{"a": []any{map[string]any{"b": map[any]any{2: 1.5}}}},
+			want: map[string]any{"a": []any{map[string]any{"b": map[string]any{"2": json.Number("1.5")}}}}},
+		{name: "typed values", value: map[string][]string{"tags": {"a"}},
+			want: map[string]any{"tags": []any{"a"}}},
+		{name: "value which can not be normalized", value: func() {}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Normalize(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Normalize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Normalize() got = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+// Package comparator holds utilities for structural comparison of documents in different data formats.
+package comparator
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pawelWritesCode/df"
+	"gopkg.in/yaml.v2"
+)
+
+// xmlTextKey is key under which text of XML element having attributes or children is stored.
+const xmlTextKey = "#text"
+
+// xmlAttributePrefix is prefix of keys under which XML element attributes are stored.
+const xmlAttributePrefix = "-"
+
+// Decode decodes data in given format into document built only from map[string]any, []any, json.Number,
+// string, bool and nil values, so documents from different sources can be compared with each other.
+//
+// XML element is decoded into map under its name. Element attributes are stored under keys prefixed with "-",
+// repeated child elements are stored as []any and text of element is stored as string or under key "#text"
+// when element has attributes or children.
+func Decode(data []byte, dataFormat df.DataFormat) (any, error) {
+	switch dataFormat {
+	case df.JSON:
+		return decodeJSON(data)
+	case df.YAML:
+		return decodeYAML(data)
+	case df.XML:
+		return decodeXML(data)
+	default:
+		return nil, fmt.Errorf("provided unknown format: %s, format should be one of : %s, %s, %s", dataFormat, df.JSON, df.YAML, df.XML)
+	}
+}
+
+// Normalize converts Go value, for example node found in document or value saved in cache, into document built
+// in the same way as by Decode, so it may be compared with other documents. Numbers of any Go type become json.Number.
+func Normalize(value any) (any, error) {
+	data, err := json.Marshal(normalizeYAML(value))
+	if err != nil {
+		return nil, fmt.Errorf("could not normalize value %v, err: %w", value, err)
+	}
+
+	return decodeJSON(data)
+}
+
+func decodeJSON(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var document any
+	if err := decoder.Decode(&document); err != nil {
+		return nil, fmt.Errorf("could not decode data in format %s, err: %w", df.JSON, err)
+	}
+
+	if decoder.More() {
+		return nil, fmt.Errorf("could not decode data in format %s, err: unexpected data after top-level value", df.JSON)
+	}
+
+	return document, nil
+}
+
+func decodeYAML(data []byte) (any, error) {
+	var document any
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("could not decode data in format %s, err: %w", df.YAML, err)
+	}
+
+	return normalizeYAML(document), nil
+}
+
+// normalizeYAML converts values produced by YAML decoder into values produced by JSON decoder.
+func normalizeYAML(value any) any {
+	switch v := value.(type) {
+	case map[any]any:
+		result := make(map[string]any, len(v))
+		for key, val := range v {
+			result[fmt.Sprint(key)] = normalizeYAML(val)
+		}
+
+		return result
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, val := range v {
+			result[key] = normalizeYAML(val)
+		}
+
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, val := range v {
+			result[i] = normalizeYAML(val)
+		}
+
+		return result
+	case int:
+		return json.Number(strconv.Itoa(v))
+	case int64:
+		return json.Number(strconv.FormatInt(v, 10))
+	case uint64:
+		return json.Number(strconv.FormatUint(v, 10))
+	case float64:
+		return json.Number(strconv.FormatFloat(v, 'f', -1, 64))
+	default:
+		return v
+	}
+}
+
+func decodeXML(data []byte) (any, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, fmt.Errorf("could not decode data in format %s, err: missing root element", df.XML)
+			}
+
+			return nil, fmt.Errorf("could not decode data in format %s, err: %w", df.XML, err)
+		}
+
+		if start, ok := token.(xml.StartElement); ok {
+			value, err := decodeXMLElement(decoder, start)
+			if err != nil {
+				return nil, fmt.Errorf("could not decode data in format %s, err: %w", df.XML, err)
+			}
+
+			return map[string]any{start.Name.Local: value}, nil
+		}
+	}
+}
+
+// decodeXMLElement decodes content of XML element which start token was already read.
+func decodeXMLElement(decoder *xml.Decoder, start xml.StartElement) (any, error) {
+	element := map[string]any{}
+	for _, attr := range start.Attr {
+		element[xmlAttributePrefix+attr.Name.Local] = attr.Value
+	}
+
+	var text strings.Builder
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			child, err := decodeXMLElement(decoder, t)
+			if err != nil {
+				return nil, err
+			}
+
+			switch existing := element[t.Name.Local].(type) {
+			case nil:
+				element[t.Name.Local] = child
+			case []any:
+				element[t.Name.Local] = append(existing, child)
+			default:
+				element[t.Name.Local] = []any{existing, child}
+			}
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			content := strings.TrimSpace(text.String())
+			if len(element) == 0 {
+				return content, nil
+			}
+
+			if content != "" {
+				element[xmlTextKey] = content
+			}
+
+			return element, nil
+		}
+	}
+}
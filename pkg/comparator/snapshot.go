@@ -0,0 +1,204 @@
+package comparator
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pawelWritesCode/df"
+	"gopkg.in/yaml.v2"
+)
+
+// UpdateSnapshotsEnv is name of environment variable, which set to "true" or "1" tells that snapshots should be
+// overwritten with actual documents instead of being compared with them.
+const UpdateSnapshotsEnv = "GDUTILS_UPDATE_SNAPSHOTS"
+
+// MaskedValue is value that replaces masked nodes of document.
+const MaskedValue = "<masked>"
+
+// ShouldUpdateSnapshots tells whether snapshots should be overwritten with actual documents.
+func ShouldUpdateSnapshots() bool {
+	update, _ := strconv.ParseBool(os.Getenv(UpdateSnapshotsEnv))
+
+	return update
+}
+
+// Mask returns copy of document produced by Decode, with nodes under paths replaced by MaskedValue.
+// Path notation is the same as in Options.IgnorePaths.
+func Mask(document any, paths []string) (any, error) {
+	patterns := make([][]string, 0, len(paths))
+	for _, path := range paths {
+		tokens, err := parsePath(path)
+		if err != nil {
+			return nil, err
+		}
+
+		patterns = append(patterns, tokens)
+	}
+
+	return mask(nil, document, patterns), nil
+}
+
+func mask(path []string, value any, patterns [][]string) any {
+	for _, pattern := range patterns {
+		if matchesPath(pattern, path) {
+			return MaskedValue
+		}
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, val := range v {
+			result[key] = mask(appendToken(path, "."+key), val, patterns)
+		}
+
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, val := range v {
+			result[i] = mask(appendToken(path, fmt.Sprintf("[%d]", i)), val, patterns)
+		}
+
+		return result
+	default:
+		return v
+	}
+}
+
+// Encode encodes document produced by Decode into pretty-printed data in given format.
+// Object keys are sorted, so encoded documents with the same content are identical.
+func Encode(document any, dataFormat df.DataFormat) ([]byte, error) {
+	switch dataFormat {
+	case df.JSON:
+		return encodeJSON(document)
+	case df.YAML:
+		return yaml.Marshal(toYAMLValues(document))
+	case df.XML:
+		return encodeXML(document)
+	default:
+		return nil, fmt.Errorf("provided unknown format: %s, format should be one of : %s, %s, %s", dataFormat, df.JSON, df.YAML, df.XML)
+	}
+}
+
+func encodeJSON(document any) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return nil, fmt.Errorf("could not encode document in format %s, err: %w", df.JSON, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// toYAMLValues converts json.Number values into numbers, so YAML encoder does not quote them.
+func toYAMLValues(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, val := range v {
+			result[key] = toYAMLValues(val)
+		}
+
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, val := range v {
+			result[i] = toYAMLValues(val)
+		}
+
+		return result
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+
+		return v.String()
+	default:
+		return v
+	}
+}
+
+func encodeXML(document any) ([]byte, error) {
+	root, ok := document.(map[string]any)
+	if !ok || len(root) != 1 {
+		return nil, fmt.Errorf("could not encode document in format %s, err: document should have exactly one root element", df.XML)
+	}
+
+	var buf bytes.Buffer
+	encoder := xml.NewEncoder(&buf)
+	encoder.Indent("", "  ")
+	for name, value := range root {
+		if err := encodeXMLElement(encoder, name, value); err != nil {
+			return nil, fmt.Errorf("could not encode document in format %s, err: %w", df.XML, err)
+		}
+	}
+
+	if err := encoder.Flush(); err != nil {
+		return nil, fmt.Errorf("could not encode document in format %s, err: %w", df.XML, err)
+	}
+
+	buf.WriteString("\n")
+
+	return buf.Bytes(), nil
+}
+
+// encodeXMLElement encodes value as XML element, reversing rules described in Decode.
+func encodeXMLElement(encoder *xml.Encoder, name string, value any) error {
+	if values, ok := value.([]any); ok {
+		for _, v := range values {
+			if err := encodeXMLElement(encoder, name, v); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+	element, isElement := value.(map[string]any)
+	if !isElement {
+		text, _ := scalarToString(value)
+
+		return encoder.EncodeElement(text, start)
+	}
+
+	children := make([]string, 0, len(element))
+	for _, key := range sortedKeys(element) {
+		if strings.HasPrefix(key, xmlAttributePrefix) {
+			text, _ := scalarToString(element[key])
+			start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: strings.TrimPrefix(key, xmlAttributePrefix)}, Value: text})
+		} else if key != xmlTextKey {
+			children = append(children, key)
+		}
+	}
+
+	if err := encoder.EncodeToken(start); err != nil {
+		return err
+	}
+
+	if text, hasText := element[xmlTextKey]; hasText {
+		str, _ := scalarToString(text)
+		if err := encoder.EncodeToken(xml.CharData(str)); err != nil {
+			return err
+		}
+	}
+
+	for _, child := range children {
+		if err := encodeXMLElement(encoder, child, element[child]); err != nil {
+			return err
+		}
+	}
+
+	return encoder.EncodeToken(start.End())
+}
@@ -0,0 +1,71 @@
+package comparator
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pawelWritesCode/df"
+)
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		dataFormat df.DataFormat
+		want       string
+	}{
+		{name: "json", data: `{"b": [1, 2.5], "a": {"c": null, "d": "<x>"}}`, dataFormat: df.JSON,
+			want: "{\n  \"a\": {\n    \"c\": null,\n    \"d\": \"<x>\"\n  },\n  \"b\": [\n    1,\n    2.5\n  ]\n}\n"},
+		{name: "yaml", data: "b:\n- 1\n- 2.5\na: abc\n", dataFormat: df.YAML, want: "a: abc\nb:\n- 1\n- 2.5\n"},
+		{name: "xml", data: `<user id="1"><tag>b</tag><name>abc</name><tag>a</tag><note lang="en">hi</note></user>`, dataFormat: df.XML,
+			want: "<user id=\"1\">\n  <name>abc</name>\n  <note lang=\"en\">hi</note>\n  <tag>b</tag>\n  <tag>a</tag>\n</user>\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			document, err := Decode([]byte(tt.data), tt.dataFormat)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := Encode(document, tt.dataFormat)
+			if err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+
+			if string(got) != tt.want {
+				t.Errorf("Encode() got = %q, want %q", got, tt.want)
+			}
+
+			decoded, err := Decode(got, tt.dataFormat)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(decoded, document) {
+				t.Errorf("encoded document should decode into the same document, got: %#v, want: %#v", decoded, document)
+			}
+		})
+	}
+
+	if _, err := Encode(map[string]any{"a": "1", "b": "2"}, df.XML); err == nil {
+		t.Errorf("Encode() expected error for XML document with many root elements")
+	}
+}
+
+func TestMask(t *testing.T) {
+	document, _ := Decode([]byte(`{"id": 1, "items": [{"id": 2, "name": "a"}, {"id": 3, "name": "b"}]}`), df.JSON)
+
+	got, err := Mask(document, []string{"$.id", "$.items[*].id"})
+	if err != nil {
+		t.Fatalf("Mask() error = %v", err)
+	}
+
+	want, _ := Decode([]byte(`{"id": "<masked>", "items": [{"id": "<masked>", "name": "a"}, {"id": "<masked>", "name": "b"}]}`), df.JSON)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Mask() got = %v, want %v", got, want)
+	}
+
+	if _, err = Mask(document, []string{"id"}); err == nil {
+		t.Errorf("Mask() expected error for invalid path")
+	}
+}
@@ -40,6 +40,11 @@ func (d *DebuggerService) IsOn() bool {
 	return d.actualState
 }
 
+// Limit returns the maximum number of bytes to be printed.
+func (d *DebuggerService) Limit() uint16 {
+	return d.limit
+}
+
 // TurnOn turns on debugging mode.
 func (d *DebuggerService) TurnOn() {
 	d.actualState = true
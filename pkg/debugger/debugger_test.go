@@ -29,6 +29,16 @@ func TestDebuggerService_TurnOn(t *testing.T) {
 	}
 }
 
+func TestDebuggerService_Limit(t *testing.T) {
+	if limit := New(false, true, 10, os.Stdout).Limit(); limit != 10 {
+		t.Errorf("Limit() = %d, want 10", limit)
+	}
+
+	if limit := NewDefault(false).Limit(); limit != 3072 {
+		t.Errorf("Limit() = %d, want 3072", limit)
+	}
+}
+
 func TestDebuggerService_TurnOff(t *testing.T) {
 	d := New(false, true, math.MaxUint16, os.Stdout)
 
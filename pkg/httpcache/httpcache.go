@@ -9,3 +9,9 @@ const LastHTTPRequestTimestamp = "LAST_HTTP_REQUEST_TIMESTAMP"
 
 // LastHTTPResponseTimestamp represents response timestamp
 const LastHTTPResponseTimestamp = "LAST_HTTP_RESPONSE_TIMESTAMP"
+
+// LastHTTPResponsesCacheKey represents cache key under which HTTP(s) responses of last concurrently sent requests are saved.
+const LastHTTPResponsesCacheKey = "LAST_HTTP_RESPONSES"
+
+// LastHTTPResponseTimingCacheKey represents cache key under which timing breakdown of last HTTP(s) request is saved.
+const LastHTTPResponseTimingCacheKey = "LAST_HTTP_RESPONSE_TIMING"
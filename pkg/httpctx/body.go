@@ -0,0 +1,126 @@
+package httpctx
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+)
+
+// DefaultMaxInMemorySize is default maximal number of bytes of response body written to file,
+// which may be loaded into memory.
+const DefaultMaxInMemorySize int64 = 10 << 20
+
+// ResponseBodyOptions describes how HTTP(s) response bodies are read.
+type ResponseBodyOptions struct {
+	// MaxSize is maximal number of bytes of response body, 0 means no limit.
+	MaxSize int64
+
+	// StreamToFile tells whether response body is written to temporary file instead of being kept in memory.
+	// Steps that check body size do not load file, APIContext.GetLastResponseBody, used by steps that check
+	// body content, loads whole file into memory on every call, but only if it is not larger than MaxInMemorySize.
+	StreamToFile bool
+
+	// MaxInMemorySize is maximal number of bytes of response body written to file, which may be loaded into memory,
+	// 0 means DefaultMaxInMemorySize.
+	MaxInMemorySize int64
+
+	// TempDir is directory of temporary files, when empty, default directory for temporary files is used.
+	TempDir string
+}
+
+// BodyTooLargeError is returned when response body is larger than ResponseBodyOptions.MaxSize.
+type BodyTooLargeError struct {
+	// MaxSize is maximal number of bytes of response body.
+	MaxSize int64
+}
+
+// Error returns message of error.
+func (e *BodyTooLargeError) Error() string {
+	return fmt.Sprintf("response body is larger than maximum size of %d bytes", e.MaxSize)
+}
+
+// InMemoryLimit returns maximal number of bytes of response body written to file, which may be loaded into memory.
+func (o ResponseBodyOptions) InMemoryLimit() int64 {
+	if o.MaxInMemorySize > 0 {
+		return o.MaxInMemorySize
+	}
+
+	return DefaultMaxInMemorySize
+}
+
+// FileBody is response body written to temporary file. Close does not close file but rewinds it,
+// so body may be read many times. File should be deleted with Remove when body is no longer needed.
+type FileBody struct {
+	// Path is path of temporary file.
+	Path string
+
+	// Size is number of bytes of body.
+	Size int64
+
+	file *os.File
+}
+
+// Read reads body from temporary file.
+func (b *FileBody) Read(p []byte) (int, error) {
+	return b.file.Read(p)
+}
+
+// Close rewinds body to its beginning.
+func (b *FileBody) Close() error {
+	_, err := b.file.Seek(0, io.SeekStart)
+
+	return err
+}
+
+// Remove closes and deletes temporary file.
+func (b *FileBody) Remove() error {
+	b.file.Close()
+
+	return os.Remove(b.Path)
+}
+
+// ReadBody reads whole body according to options and returns its copy, which may be used as response body.
+// Copy is kept in memory or, if options.StreamToFile is set, is *FileBody. Body is not closed.
+func ReadBody(body io.Reader, options ResponseBodyOptions) (io.ReadCloser, error) {
+	reader := body
+	if options.MaxSize > 0 {
+		reader = io.LimitReader(body, options.MaxSize+1)
+	}
+
+	if !options.StreamToFile {
+		data, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, err
+		}
+
+		if options.MaxSize > 0 && int64(len(data)) > options.MaxSize {
+			return nil, &BodyTooLargeError{MaxSize: options.MaxSize}
+		}
+
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+
+	file, err := os.CreateTemp(options.TempDir, "gdutils-response-*")
+	if err != nil {
+		return nil, fmt.Errorf("could not create temporary file for response body, err: %w", err)
+	}
+
+	fileBody := &FileBody{Path: file.Name(), file: file}
+	fileBody.Size, err = io.Copy(file, reader)
+	if err == nil && options.MaxSize > 0 && fileBody.Size > options.MaxSize {
+		err = &BodyTooLargeError{MaxSize: options.MaxSize}
+	}
+
+	if err == nil {
+		err = fileBody.Close()
+	}
+
+	if err != nil {
+		fileBody.Remove()
+
+		return nil, err
+	}
+
+	return fileBody, nil
+}
@@ -0,0 +1,78 @@
+package httpctx
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestReadBody(t *testing.T) {
+	tempDir := t.TempDir()
+
+	tests := []struct {
+		name     string
+		body     string
+		options  ResponseBodyOptions
+		wantFile bool
+		wantErr  bool
+	}{
+		{name: "memory without limit", body: "abcdef"},
+		{name: "memory within limit", body: "abcdef", options: ResponseBodyOptions{MaxSize: 6}},
+		{name: "memory over limit", body: "abcdef", options: ResponseBodyOptions{MaxSize: 5}, wantErr: true},
+		{name: "file within limit", body: "abcdef", options: ResponseBodyOptions{MaxSize: 6, StreamToFile: true, TempDir: tempDir}, wantFile: true},
+		{name: "file over limit", body: "abcdef", options: ResponseBodyOptions{MaxSize: 5, StreamToFile: true, TempDir: tempDir}, wantErr: true},
+		{name: "empty file", body: "", options: ResponseBodyOptions{StreamToFile: true, TempDir: tempDir}, wantFile: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadBody(strings.NewReader(tt.body), tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadBody() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				var tooLargeErr *BodyTooLargeError
+				if !errors.As(err, &tooLargeErr) || tooLargeErr.MaxSize != tt.options.MaxSize {
+					t.Errorf("ReadBody() error = %v, want BodyTooLargeError", err)
+				}
+
+				return
+			}
+
+			fileBody, isFile := got.(*FileBody)
+			if isFile != tt.wantFile {
+				t.Fatalf("ReadBody() returned %T, want file: %v", got, tt.wantFile)
+			}
+
+			data, _ := io.ReadAll(got)
+			got.Close()
+			if string(data) != tt.body {
+				t.Errorf("body got = %s, want %s", data, tt.body)
+			}
+
+			if isFile {
+				if data, _ = io.ReadAll(got); string(data) != tt.body {
+					t.Errorf("body written to file should be read again after close, got = %s, want %s", data, tt.body)
+				}
+
+				if fileBody.Size != int64(len(tt.body)) {
+					t.Errorf("FileBody.Size = %d, want %d", fileBody.Size, len(tt.body))
+				}
+
+				if err = fileBody.Remove(); err != nil {
+					t.Errorf("Remove() error = %v", err)
+				}
+
+				if _, err = os.Stat(fileBody.Path); !os.IsNotExist(err) {
+					t.Errorf("temporary file should be deleted, err: %v", err)
+				}
+			}
+		})
+	}
+
+	if entries, _ := os.ReadDir(tempDir); len(entries) != 0 {
+		t.Errorf("all temporary files should be deleted, got %d", len(entries))
+	}
+}
@@ -0,0 +1,46 @@
+package httpctx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseCacheControl returns directives of Cache-Control header values. Directive names are lowercase,
+// directives without argument, for example: no-store, have empty value and quoted arguments, which may contain commas,
+// are unquoted.
+// When directive occurs more than once, first occurrence is returned.
+func ParseCacheControl(values []string) map[string]string {
+	directives := make(map[string]string)
+	for _, value := range values {
+		for _, directive := range splitHeaderValue(value) {
+			name, argument, _ := strings.Cut(strings.TrimSpace(directive), "=")
+			name = strings.ToLower(strings.TrimSpace(name))
+			if name == "" {
+				continue
+			}
+
+			if _, ok := directives[name]; !ok {
+				directives[name] = strings.Trim(strings.TrimSpace(argument), `"`)
+			}
+		}
+	}
+
+	return directives
+}
+
+// CacheControlSeconds returns value of Cache-Control directive holding number of seconds, for example: max-age.
+func CacheControlSeconds(directives map[string]string, name string) (time.Duration, error) {
+	argument, ok := directives[strings.ToLower(name)]
+	if !ok {
+		return 0, fmt.Errorf("Cache-Control header does not have %s directive", name)
+	}
+
+	seconds, err := strconv.ParseUint(argument, 10, 63)
+	if err != nil {
+		return 0, fmt.Errorf("Cache-Control header %s directive '%s' is not valid number of seconds", name, argument)
+	}
+
+	return time.Duration(seconds) * time.Second, nil
+}
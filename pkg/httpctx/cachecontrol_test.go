@@ -0,0 +1,61 @@
+package httpctx
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseCacheControl(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   map[string]string
+	}{
+		{name: "no values", values: nil, want: map[string]string{}},
+		{name: "directives with and without arguments", values: []string{`Private, Max-Age=60, no-cache="Set-Cookie"`}, want: map[string]string{
+			"private": "", "max-age": "60", "no-cache": "Set-Cookie",
+		}},
+		{name: "quoted argument with commas", values: []string{`no-cache="Set-Cookie, X-Foo", max-age=60`}, want: map[string]string{
+			"no-cache": "Set-Cookie, X-Foo", "max-age": "60",
+		}},
+		{name: "multiple values and first occurrence", values: []string{"max-age=60, ,", "max-age=120, no-store"}, want: map[string]string{
+			"max-age": "60", "no-store": "",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseCacheControl(tt.values); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseCacheControl() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCacheControlSeconds(t *testing.T) {
+	directives := map[string]string{"max-age": "60", "s-maxage": "-1", "private": ""}
+
+	tests := []struct {
+		name      string
+		directive string
+		want      time.Duration
+		wantErr   bool
+	}{
+		{name: "seconds", directive: "Max-Age", want: time.Minute},
+		{name: "missing directive", directive: "stale-if-error", wantErr: true},
+		{name: "negative seconds", directive: "s-maxage", wantErr: true},
+		{name: "directive without argument", directive: "private", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CacheControlSeconds(directives, tt.directive)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CacheControlSeconds() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("CacheControlSeconds() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
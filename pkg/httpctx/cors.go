@@ -0,0 +1,177 @@
+package httpctx
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// HeaderAllowOrigin is name of CORS response header holding allowed origin.
+	HeaderAllowOrigin = "Access-Control-Allow-Origin"
+
+	// HeaderAllowMethods is name of CORS response header holding allowed methods.
+	HeaderAllowMethods = "Access-Control-Allow-Methods"
+
+	// HeaderAllowHeaders is name of CORS response header holding allowed request headers.
+	HeaderAllowHeaders = "Access-Control-Allow-Headers"
+
+	// HeaderAllowCredentials is name of CORS response header telling whether credentials are allowed.
+	HeaderAllowCredentials = "Access-Control-Allow-Credentials"
+
+	// HeaderMaxAge is name of CORS response header holding number of seconds preflight response may be cached.
+	HeaderMaxAge = "Access-Control-Max-Age"
+
+	// HeaderRequestMethod is name of preflight request header holding method of actual request.
+	HeaderRequestMethod = "Access-Control-Request-Method"
+
+	// HeaderRequestHeaders is name of preflight request header holding headers of actual request.
+	HeaderRequestHeaders = "Access-Control-Request-Headers"
+)
+
+// safelistedMethods are methods allowed by browsers regardless of Access-Control-Allow-Methods header.
+var safelistedMethods = []string{http.MethodGet, http.MethodHead, http.MethodPost}
+
+// CORSAllowsCredentials checks whether CORS response headers allow sending credentials.
+// Credentials are allowed, when Access-Control-Allow-Credentials is "true" and allowed origin is not wildcard.
+func CORSAllowsCredentials(header http.Header) error {
+	if value := header.Get(HeaderAllowCredentials); value != "true" {
+		return fmt.Errorf("%s header should be 'true', got '%s'", HeaderAllowCredentials, value)
+	}
+
+	if header.Get(HeaderAllowOrigin) == "*" {
+		return fmt.Errorf("%s header '*' does not allow credentials", HeaderAllowOrigin)
+	}
+
+	return nil
+}
+
+// CORSAllowsOrigin checks whether CORS response headers allow requests from origin.
+// Wildcard "*" allows every origin, but only for requests without credentials.
+func CORSAllowsOrigin(header http.Header, origin string) error {
+	allowed := header.Values(HeaderAllowOrigin)
+	if len(allowed) != 1 {
+		return fmt.Errorf("%s header should have exactly one value, got %q", HeaderAllowOrigin, allowed)
+	}
+
+	if allowed[0] == "*" {
+		if header.Get(HeaderAllowCredentials) == "true" {
+			return fmt.Errorf("%s header '*' is not valid together with %s: true", HeaderAllowOrigin, HeaderAllowCredentials)
+		}
+
+		return nil
+	}
+
+	if allowed[0] != origin {
+		return fmt.Errorf("%s header is '%s', but expected '%s'", HeaderAllowOrigin, allowed[0], origin)
+	}
+
+	return nil
+}
+
+// CORSAllowsMethods checks whether CORS response headers allow every method from methods.
+// Methods are case-sensitive, GET, HEAD and POST are always allowed and wildcard "*" allows every method,
+// but only for requests without credentials.
+func CORSAllowsMethods(header http.Header, methods []string) error {
+	allowed := headerList(header.Values(HeaderAllowMethods))
+	isWildcard := containsString(allowed, "*") && header.Get(HeaderAllowCredentials) != "true"
+
+	notAllowed := make([]string, 0)
+	for _, method := range methods {
+		if !isWildcard && !containsString(allowed, method) && !containsString(safelistedMethods, method) {
+			notAllowed = append(notAllowed, method)
+		}
+	}
+
+	if len(notAllowed) > 0 {
+		return fmt.Errorf("%s header %q does not allow methods: %q", HeaderAllowMethods, allowed, notAllowed)
+	}
+
+	return nil
+}
+
+// CORSAllowsHeaders checks whether CORS response headers allow every request header from headers.
+// Header names are case-insensitive and wildcard "*" allows every header except Authorization,
+// but only for requests without credentials.
+func CORSAllowsHeaders(header http.Header, headers []string) error {
+	allowed := headerList(header.Values(HeaderAllowHeaders))
+	isWildcard := containsString(allowed, "*") && header.Get(HeaderAllowCredentials) != "true"
+
+	notAllowed := make([]string, 0)
+	for _, name := range headers {
+		if isWildcard && !strings.EqualFold(name, "Authorization") {
+			continue
+		}
+
+		isAllowed := false
+		for _, allowedName := range allowed {
+			if strings.EqualFold(allowedName, name) {
+				isAllowed = true
+				break
+			}
+		}
+
+		if !isAllowed {
+			notAllowed = append(notAllowed, name)
+		}
+	}
+
+	if len(notAllowed) > 0 {
+		return fmt.Errorf("%s header %q does not allow headers: %q", HeaderAllowHeaders, allowed, notAllowed)
+	}
+
+	return nil
+}
+
+// CORSVariesByOrigin checks whether Vary header tells caches that response depends on Origin request header.
+func CORSVariesByOrigin(header http.Header) error {
+	vary := headerList(header.Values("Vary"))
+	for _, name := range vary {
+		if name == "*" || strings.EqualFold(name, "Origin") {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Vary header %q does not include Origin", vary)
+}
+
+// CORSMaxAge returns time for which preflight response may be cached, obtained from Access-Control-Max-Age header.
+func CORSMaxAge(header http.Header) (time.Duration, error) {
+	value := header.Get(HeaderMaxAge)
+	if value == "" {
+		return 0, fmt.Errorf("%s header is missing", HeaderMaxAge)
+	}
+
+	seconds, err := strconv.ParseUint(strings.TrimSpace(value), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%s header '%s' is not valid number of seconds", HeaderMaxAge, value)
+	}
+
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// headerList returns elements of comma separated header values.
+func headerList(values []string) []string {
+	elements := make([]string, 0)
+	for _, value := range values {
+		for _, element := range strings.Split(value, ",") {
+			if element = strings.TrimSpace(element); element != "" {
+				elements = append(elements, element)
+			}
+		}
+	}
+
+	return elements
+}
+
+func containsString(elements []string, s string) bool {
+	for _, element := range elements {
+		if element == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,128 @@
+package httpctx
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCORSAllowsOrigin(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  http.Header
+		origin  string
+		wantErr bool
+	}{
+		{name: "missing header", header: http.Header{}, origin: "https://a.com", wantErr: true},
+		{name: "exact origin", header: http.Header{HeaderAllowOrigin: {"https://a.com"}}, origin: "https://a.com"},
+		{name: "different origin", header: http.Header{HeaderAllowOrigin: {"https://a.com"}}, origin: "https://b.com", wantErr: true},
+		{name: "multiple values", header: http.Header{HeaderAllowOrigin: {"https://a.com", "https://b.com"}}, origin: "https://a.com", wantErr: true},
+		{name: "wildcard", header: http.Header{HeaderAllowOrigin: {"*"}}, origin: "https://a.com"},
+		{name: "wildcard with credentials", header: http.Header{HeaderAllowOrigin: {"*"}, HeaderAllowCredentials: {"true"}},
+			origin: "https://a.com", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CORSAllowsOrigin(tt.header, tt.origin); (err != nil) != tt.wantErr {
+				t.Errorf("CORSAllowsOrigin() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCORSAllowsMethods(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  http.Header
+		methods []string
+		wantErr bool
+	}{
+		{name: "listed methods", header: http.Header{HeaderAllowMethods: {"PUT, DELETE"}}, methods: []string{"PUT", "DELETE"}},
+		{name: "safelisted method", header: http.Header{}, methods: []string{"POST"}},
+		{name: "methods are case-sensitive", header: http.Header{HeaderAllowMethods: {"put"}}, methods: []string{"PUT"}, wantErr: true},
+		{name: "wildcard", header: http.Header{HeaderAllowMethods: {"*"}}, methods: []string{"PATCH"}},
+		{name: "wildcard with credentials", header: http.Header{HeaderAllowMethods: {"*"}, HeaderAllowCredentials: {"true"}},
+			methods: []string{"PATCH"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CORSAllowsMethods(tt.header, tt.methods); (err != nil) != tt.wantErr {
+				t.Errorf("CORSAllowsMethods() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCORSAllowsHeaders(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  http.Header
+		headers []string
+		wantErr bool
+	}{
+		{name: "listed headers case-insensitive", header: http.Header{HeaderAllowHeaders: {"content-type", "X-Request-Id"}},
+			headers: []string{"Content-Type", "x-request-id"}},
+		{name: "not listed header", header: http.Header{HeaderAllowHeaders: {"Content-Type"}}, headers: []string{"X-Api-Key"}, wantErr: true},
+		{name: "wildcard", header: http.Header{HeaderAllowHeaders: {"*"}}, headers: []string{"X-Api-Key"}},
+		{name: "wildcard does not cover authorization", header: http.Header{HeaderAllowHeaders: {"*"}},
+			headers: []string{"Authorization"}, wantErr: true},
+		{name: "wildcard with credentials", header: http.Header{HeaderAllowHeaders: {"*"}, HeaderAllowCredentials: {"true"}},
+			headers: []string{"X-Api-Key"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CORSAllowsHeaders(tt.header, tt.headers); (err != nil) != tt.wantErr {
+				t.Errorf("CORSAllowsHeaders() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCORSAllowsCredentials(t *testing.T) {
+	if err := CORSAllowsCredentials(http.Header{HeaderAllowCredentials: {"true"}, HeaderAllowOrigin: {"https://a.com"}}); err != nil {
+		t.Errorf("CORSAllowsCredentials() error = %v", err)
+	}
+
+	if err := CORSAllowsCredentials(http.Header{HeaderAllowCredentials: {"true"}, HeaderAllowOrigin: {"*"}}); err == nil {
+		t.Errorf("CORSAllowsCredentials() expected error for wildcard origin")
+	}
+
+	if err := CORSAllowsCredentials(http.Header{HeaderAllowCredentials: {"TRUE"}}); err == nil {
+		t.Errorf("CORSAllowsCredentials() expected error for value other than true")
+	}
+}
+
+func TestCORSVariesByOrigin(t *testing.T) {
+	if err := CORSVariesByOrigin(http.Header{"Vary": {"Accept-Encoding, origin"}}); err != nil {
+		t.Errorf("CORSVariesByOrigin() error = %v", err)
+	}
+
+	if err := CORSVariesByOrigin(http.Header{"Vary": {"Accept-Encoding"}}); err == nil {
+		t.Errorf("CORSVariesByOrigin() expected error when Vary does not include Origin")
+	}
+}
+
+func TestCORSMaxAge(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  http.Header
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "missing header", header: http.Header{}, wantErr: true},
+		{name: "seconds", header: http.Header{HeaderMaxAge: {"600"}}, want: 10 * time.Minute},
+		{name: "negative", header: http.Header{HeaderMaxAge: {"-1"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CORSMaxAge(tt.header)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CORSMaxAge() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("CORSMaxAge() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
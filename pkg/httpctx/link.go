@@ -0,0 +1,54 @@
+package httpctx
+
+import "strings"
+
+// LinkByRel returns target URI of first link with given relation type from values of Link header, described in RFC 8288,
+// for example: <https://api.example.com/items?page=2>; rel="next". Relation types are compared case-insensitively.
+func LinkByRel(values []string, rel string) (string, bool) {
+	for _, value := range values {
+		for _, link := range splitHeaderValue(value) {
+			start, end := strings.Index(link, "<"), strings.Index(link, ">")
+			if start != 0 || end < 0 {
+				continue
+			}
+
+			for _, param := range strings.Split(link[end+1:], ";") {
+				name, paramValue, _ := strings.Cut(strings.TrimSpace(param), "=")
+				if !strings.EqualFold(strings.TrimSpace(name), "rel") {
+					continue
+				}
+
+				for _, relationType := range strings.Fields(strings.Trim(strings.TrimSpace(paramValue), `"`)) {
+					if strings.EqualFold(relationType, rel) {
+						return strings.TrimSpace(link[1:end]), true
+					}
+				}
+			}
+		}
+	}
+
+	return "", false
+}
+
+// splitHeaderValue splits comma separated header value, for example value of Link or Cache-Control header,
+// into elements, ignoring commas inside URIs and quoted strings.
+func splitHeaderValue(value string) []string {
+	elements := make([]string, 0)
+	isInURI, isInQuotes := false, false
+	start := 0
+	for i, r := range value {
+		switch {
+		case r == '<' && !isInQuotes:
+			isInURI = true
+		case r == '>' && !isInQuotes:
+			isInURI = false
+		case r == '"' && !isInURI:
+			isInQuotes = !isInQuotes
+		case r == ',' && !isInURI && !isInQuotes:
+			elements = append(elements, strings.TrimSpace(value[start:i]))
+			start = i + 1
+		}
+	}
+
+	return append(elements, strings.TrimSpace(value[start:]))
+}
@@ -0,0 +1,30 @@
+package httpctx
+
+import "testing"
+
+func TestLinkByRel(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		rel    string
+		want   string
+		wantOk bool
+	}{
+		{name: "no values", values: nil, rel: "next", want: "", wantOk: false},
+		{name: "next link", values: []string{`<https://api.example.com/items?page=1>; rel="prev", <https://api.example.com/items?page=3>; rel="next"`},
+			rel: "next", want: "https://api.example.com/items?page=3", wantOk: true},
+		{name: "comma inside URI and title", values: []string{`</items?ids=1,2>; title="a, b"; rel=next`}, rel: "NEXT", want: "/items?ids=1,2", wantOk: true},
+		{name: "multiple relation types", values: []string{`</items?page=2>; rel="next last"`}, rel: "last", want: "/items?page=2", wantOk: true},
+		{name: "link in second header value", values: []string{`</a>; rel="first"`, `</b>; rel="next"`}, rel: "next", want: "/b", wantOk: true},
+		{name: "missing relation type", values: []string{`</items?page=1>; rel="prev"`}, rel: "next", want: "", wantOk: false},
+		{name: "malformed link", values: []string{`/items?page=2; rel="next"`}, rel: "next", want: "", wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := LinkByRel(tt.values, tt.rel)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("LinkByRel() got = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
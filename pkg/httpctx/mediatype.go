@@ -0,0 +1,41 @@
+package httpctx
+
+import (
+	"fmt"
+	"mime"
+	"strings"
+)
+
+// MediaTypesEqual checks whether two media types, for example values of Content-Type header, are equal.
+// Type, subtype and parameter names are compared case-insensitively, order of parameters does not matter
+// and value of charset parameter is compared case-insensitively too, for example:
+// "application/json; charset=UTF-8" is equal to "Application/JSON;charset=utf-8". Only parameters of expected
+// media type are compared, so expected "application/json" is equal to actual "application/json; charset=utf-8".
+func MediaTypesEqual(expected, actual string) (bool, error) {
+	expectedType, expectedParams, err := mime.ParseMediaType(expected)
+	if err != nil {
+		return false, fmt.Errorf("could not parse media type '%s', err: %w", expected, err)
+	}
+
+	actualType, actualParams, err := mime.ParseMediaType(actual)
+	if err != nil {
+		return false, fmt.Errorf("could not parse media type '%s', err: %w", actual, err)
+	}
+
+	if expectedType != actualType {
+		return false, nil
+	}
+
+	for name, expectedValue := range expectedParams {
+		actualValue, ok := actualParams[name]
+		if !ok {
+			return false, nil
+		}
+
+		if name == "charset" && !strings.EqualFold(expectedValue, actualValue) || name != "charset" && expectedValue != actualValue {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,38 @@
+package httpctx
+
+import "testing"
+
+func TestMediaTypesEqual(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+		actual   string
+		want     bool
+		wantErr  bool
+	}{
+		{name: "equal", expected: "application/json", actual: "application/json", want: true},
+		{name: "different case of type", expected: "application/json", actual: "Application/JSON", want: true},
+		{name: "different charset case", expected: "application/json; charset=utf-8", actual: "application/json;charset=UTF-8", want: true},
+		{name: "different parameters order", expected: `multipart/form-data; charset=utf-8; boundary=abc`, actual: `multipart/form-data; boundary="abc"; charset=utf-8`, want: true},
+		{name: "different boundary case", expected: "multipart/form-data; boundary=abc", actual: "multipart/form-data; boundary=ABC", want: false},
+		{name: "different subtype", expected: "application/json", actual: "application/problem+json", want: false},
+		{name: "missing parameter", expected: "application/json; charset=utf-8", actual: "application/json", want: false},
+		{name: "parameter not specified in expected", expected: "application/json", actual: "application/json; charset=utf-8", want: true},
+		{name: "only expected parameters compared", expected: "multipart/form-data; boundary=abc", actual: "multipart/form-data; charset=utf-8; boundary=abc", want: true},
+		{name: "different parameter", expected: "text/plain; charset=utf-8", actual: "text/plain; format=flowed", want: false},
+		{name: "invalid expected media type", expected: "application/json; charset", actual: "application/json", wantErr: true},
+		{name: "invalid actual media type", expected: "application/json", actual: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MediaTypesEqual(tt.expected, tt.actual)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MediaTypesEqual() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("MediaTypesEqual() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package httpctx
+
+import (
+	"mime"
+	"strings"
+)
+
+// ProblemDetailsMediaType is media type of RFC 7807 problem details document in JSON format.
+const ProblemDetailsMediaType = "application/problem+json"
+
+// ProblemDetailsDefaultType is value of problem details type member, when member is not present.
+const ProblemDetailsDefaultType = "about:blank"
+
+// ProblemDetailsSchema is JSON schema describing members of RFC 7807 problem details document.
+// Extension members are allowed.
+const ProblemDetailsSchema = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "properties": {
+    "type": {"type": "string", "format": "uri-reference"},
+    "title": {"type": "string"},
+    "status": {"type": "integer", "minimum": 100, "maximum": 599},
+    "detail": {"type": "string"},
+    "instance": {"type": "string", "format": "uri-reference"}
+  }
+}`
+
+// IsProblemDetailsMediaType checks whether value of Content-Type header describes problem details document in JSON format.
+func IsProblemDetailsMediaType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(mediaType, ProblemDetailsMediaType)
+}
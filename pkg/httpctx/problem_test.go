@@ -0,0 +1,24 @@
+package httpctx
+
+import "testing"
+
+func TestIsProblemDetailsMediaType(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		want        bool
+	}{
+		{name: "problem details", contentType: "application/problem+json", want: true},
+		{name: "problem details with charset", contentType: "Application/Problem+JSON; charset=utf-8", want: true},
+		{name: "plain JSON", contentType: "application/json", want: false},
+		{name: "problem details in XML", contentType: "application/problem+xml", want: false},
+		{name: "empty", contentType: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsProblemDetailsMediaType(tt.contentType); got != tt.want {
+				t.Errorf("IsProblemDetailsMediaType() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
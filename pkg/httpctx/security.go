@@ -0,0 +1,186 @@
+package httpctx
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// versionRegExp matches version numbers leaked in headers, for example: nginx/1.19.0, PHP/7.4 or Apache 2.4.
+var versionRegExp = regexp.MustCompile(`/\s*v?\d|\d+\.\d+`)
+
+// SecurityHeadersPolicy describes security headers expected in HTTP(s) response.
+// Zero value of each field turns off corresponding check.
+type SecurityHeadersPolicy struct {
+	// HSTSMinMaxAge is minimum max-age directive of Strict-Transport-Security header.
+	HSTSMinMaxAge time.Duration
+
+	// HSTSIncludeSubDomains tells whether Strict-Transport-Security header should have includeSubDomains directive.
+	HSTSIncludeSubDomains bool
+
+	// RequireNoSniff tells whether X-Content-Type-Options header should be equal to nosniff.
+	RequireNoSniff bool
+
+	// RequireCSP tells whether Content-Security-Policy header should be present.
+	RequireCSP bool
+
+	// CSPDirectives are directives that Content-Security-Policy header should have, for example: default-src.
+	// Directive followed by value, for example: frame-ancestors 'none', should have exactly that value.
+	CSPDirectives []string
+
+	// ReferrerPolicies are allowed values of Referrer-Policy header, compared case-insensitively.
+	ReferrerPolicies []string
+
+	// ForbidVersionLeaks tells whether Server and X-Powered-By headers should not reveal software versions.
+	ForbidVersionLeaks bool
+}
+
+// DefaultSecurityHeadersPolicy returns policy requiring Strict-Transport-Security with max-age of at least 180 days,
+// X-Content-Type-Options: nosniff, Content-Security-Policy, Referrer-Policy not leaking full URLs to other origins
+// and no software versions in Server and X-Powered-By headers.
+func DefaultSecurityHeadersPolicy() SecurityHeadersPolicy {
+	return SecurityHeadersPolicy{
+		HSTSMinMaxAge:  180 * 24 * time.Hour,
+		RequireNoSniff: true,
+		RequireCSP:     true,
+		ReferrerPolicies: []string{
+			"no-referrer",
+			"same-origin",
+			"strict-origin",
+			"strict-origin-when-cross-origin",
+		},
+		ForbidVersionLeaks: true,
+	}
+}
+
+// Violations returns every violation of policy found in HTTP(s) headers.
+func (p SecurityHeadersPolicy) Violations(header http.Header) []string {
+	violations := make([]string, 0)
+
+	if p.HSTSMinMaxAge > 0 || p.HSTSIncludeSubDomains {
+		violations = append(violations, p.hstsViolations(header.Get("Strict-Transport-Security"))...)
+	}
+
+	if p.RequireNoSniff {
+		if value := header.Get("X-Content-Type-Options"); !strings.EqualFold(strings.TrimSpace(value), "nosniff") {
+			violations = append(violations, fmt.Sprintf("X-Content-Type-Options: expected 'nosniff', got '%s'", value))
+		}
+	}
+
+	if p.RequireCSP || len(p.CSPDirectives) > 0 {
+		violations = append(violations, p.cspViolations(header.Values("Content-Security-Policy"))...)
+	}
+
+	if len(p.ReferrerPolicies) > 0 {
+		violations = append(violations, p.referrerPolicyViolations(header.Get("Referrer-Policy"))...)
+	}
+
+	if p.ForbidVersionLeaks {
+		for _, name := range []string{"Server", "X-Powered-By"} {
+			for _, value := range header.Values(name) {
+				if versionRegExp.MatchString(value) {
+					violations = append(violations, fmt.Sprintf("%s: reveals software version: '%s'", name, value))
+				}
+			}
+		}
+	}
+
+	return violations
+}
+
+func (p SecurityHeadersPolicy) hstsViolations(value string) []string {
+	if value == "" {
+		return []string{"Strict-Transport-Security: header is missing"}
+	}
+
+	violations := make([]string, 0)
+	maxAge := -1
+	hasIncludeSubDomains := false
+	for _, directive := range strings.Split(value, ";") {
+		name, directiveValue, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "max-age":
+			seconds, err := strconv.Atoi(strings.Trim(strings.TrimSpace(directiveValue), `"`))
+			if err != nil {
+				return []string{fmt.Sprintf("Strict-Transport-Security: invalid max-age directive: '%s'", directiveValue)}
+			}
+
+			maxAge = seconds
+		case "includesubdomains":
+			hasIncludeSubDomains = true
+		}
+	}
+
+	if maxAge < 0 {
+		violations = append(violations, "Strict-Transport-Security: max-age directive is missing")
+	} else if age := time.Duration(maxAge) * time.Second; age < p.HSTSMinMaxAge {
+		violations = append(violations, fmt.Sprintf("Strict-Transport-Security: max-age=%d is lower than required %d", maxAge, int64(p.HSTSMinMaxAge.Seconds())))
+	}
+
+	if p.HSTSIncludeSubDomains && !hasIncludeSubDomains {
+		violations = append(violations, "Strict-Transport-Security: includeSubDomains directive is missing")
+	}
+
+	return violations
+}
+
+func (p SecurityHeadersPolicy) cspViolations(values []string) []string {
+	if len(values) == 0 {
+		return []string{"Content-Security-Policy: header is missing"}
+	}
+
+	directives := make(map[string]string)
+	for _, value := range values {
+		for _, directive := range strings.Split(value, ";") {
+			fields := strings.Fields(directive)
+			if len(fields) == 0 {
+				continue
+			}
+
+			name := strings.ToLower(fields[0])
+			if _, ok := directives[name]; !ok {
+				directives[name] = strings.Join(fields[1:], " ")
+			}
+		}
+	}
+
+	violations := make([]string, 0)
+	for _, required := range p.CSPDirectives {
+		fields := strings.Fields(required)
+		if len(fields) == 0 {
+			continue
+		}
+
+		actual, ok := directives[strings.ToLower(fields[0])]
+		if !ok {
+			violations = append(violations, fmt.Sprintf("Content-Security-Policy: %s directive is missing", fields[0]))
+			continue
+		}
+
+		if expected := strings.Join(fields[1:], " "); expected != "" && expected != actual {
+			violations = append(violations, fmt.Sprintf("Content-Security-Policy: %s directive expected '%s', got '%s'", fields[0], expected, actual))
+		}
+	}
+
+	return violations
+}
+
+func (p SecurityHeadersPolicy) referrerPolicyViolations(value string) []string {
+	if value == "" {
+		return []string{"Referrer-Policy: header is missing"}
+	}
+
+	// browsers use last recognized value, when header holds comma separated fallback list
+	policies := strings.Split(value, ",")
+	actual := strings.TrimSpace(policies[len(policies)-1])
+	for _, allowed := range p.ReferrerPolicies {
+		if strings.EqualFold(actual, allowed) {
+			return nil
+		}
+	}
+
+	return []string{fmt.Sprintf("Referrer-Policy: '%s' is not one of allowed: %s", actual, strings.Join(p.ReferrerPolicies, ", "))}
+}
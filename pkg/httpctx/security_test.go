@@ -0,0 +1,62 @@
+package httpctx
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSecurityHeadersPolicy_Violations(t *testing.T) {
+	secure := http.Header{
+		"Strict-Transport-Security": []string{"max-age=31536000; includeSubDomains"},
+		"X-Content-Type-Options":    []string{"nosniff"},
+		"Content-Security-Policy":   []string{"default-src 'self'; frame-ancestors 'none'"},
+		"Referrer-Policy":           []string{"no-referrer, strict-origin-when-cross-origin"},
+		"Server":                    []string{"nginx"},
+	}
+
+	tests := []struct {
+		name   string
+		policy SecurityHeadersPolicy
+		header http.Header
+		want   []string
+	}{
+		{name: "empty policy", policy: SecurityHeadersPolicy{}, header: http.Header{}, want: []string{}},
+		{name: "default policy satisfied", policy: DefaultSecurityHeadersPolicy(), header: secure, want: []string{}},
+		{name: "default policy - missing headers", policy: DefaultSecurityHeadersPolicy(), header: http.Header{
+			"Server":       []string{"Apache/2.4.41 (Ubuntu)"},
+			"X-Powered-By": []string{"PHP/7.4.3"},
+		}, want: []string{
+			"Strict-Transport-Security: header is missing",
+			"X-Content-Type-Options: expected 'nosniff', got ''",
+			"Content-Security-Policy: header is missing",
+			"Referrer-Policy: header is missing",
+			"Server: reveals software version: 'Apache/2.4.41 (Ubuntu)'",
+			"X-Powered-By: reveals software version: 'PHP/7.4.3'",
+		}},
+		{name: "hsts too short and without subdomains", policy: SecurityHeadersPolicy{HSTSMinMaxAge: time.Hour, HSTSIncludeSubDomains: true},
+			header: http.Header{"Strict-Transport-Security": []string{`max-age="60"`}}, want: []string{
+				"Strict-Transport-Security: max-age=60 is lower than required 3600",
+				"Strict-Transport-Security: includeSubDomains directive is missing",
+			}},
+		{name: "hsts without max-age", policy: SecurityHeadersPolicy{HSTSMinMaxAge: time.Hour},
+			header: http.Header{"Strict-Transport-Security": []string{"includeSubDomains"}},
+			want:   []string{"Strict-Transport-Security: max-age directive is missing"}},
+		{name: "csp directives", policy: SecurityHeadersPolicy{CSPDirectives: []string{"default-src", "frame-ancestors 'self'", "script-src"}},
+			header: secure, want: []string{
+				"Content-Security-Policy: frame-ancestors directive expected ''self'', got ''none''",
+				"Content-Security-Policy: script-src directive is missing",
+			}},
+		{name: "referrer policy not allowed", policy: SecurityHeadersPolicy{ReferrerPolicies: []string{"no-referrer"}},
+			header: http.Header{"Referrer-Policy": []string{"unsafe-url"}},
+			want:   []string{"Referrer-Policy: 'unsafe-url' is not one of allowed: no-referrer"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Violations(tt.header); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Violations() got = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
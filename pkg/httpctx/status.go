@@ -0,0 +1,74 @@
+package httpctx
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// IsOfStatusClass checks whether HTTP status code belongs to status class, for example: 204 belongs to class "2xx".
+// Status class is case-insensitive and should consist of digit from 1 to 5 followed by "xx".
+func IsOfStatusClass(code int, class string) (bool, error) {
+	normalized := strings.ToLower(strings.TrimSpace(class))
+	if len(normalized) != 3 || !strings.HasSuffix(normalized, "xx") || normalized[0] < '1' || normalized[0] > '5' {
+		return false, fmt.Errorf("invalid status class: '%s', expected one of: 1xx, 2xx, 3xx, 4xx, 5xx", class)
+	}
+
+	classDigit, _ := strconv.Atoi(normalized[:1])
+
+	return code/100 == classDigit, nil
+}
+
+// ParseStatusCodeCounts parses comma separated list of status codes with number of their occurrences,
+// for example: "201: 1, 409: 19".
+func ParseStatusCodeCounts(counts string) (map[int]int, error) {
+	parsed := make(map[int]int)
+	for _, entry := range strings.Split(counts, ",") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+
+		code, count, found := strings.Cut(entry, ":")
+		if !found {
+			return nil, fmt.Errorf("invalid status code count: '%s', expected format: <code>: <count>", strings.TrimSpace(entry))
+		}
+
+		codeNumber, err := strconv.Atoi(strings.TrimSpace(code))
+		if err != nil || codeNumber < 100 || codeNumber > 599 {
+			return nil, fmt.Errorf("invalid status code: '%s'", strings.TrimSpace(code))
+		}
+
+		countNumber, err := strconv.Atoi(strings.TrimSpace(count))
+		if err != nil || countNumber < 0 {
+			return nil, fmt.Errorf("invalid count of status code %d: '%s'", codeNumber, strings.TrimSpace(count))
+		}
+
+		if _, ok := parsed[codeNumber]; ok {
+			return nil, fmt.Errorf("status code %d is listed more than once", codeNumber)
+		}
+
+		parsed[codeNumber] = countNumber
+	}
+
+	return parsed, nil
+}
+
+// FormatStatusCodeCounts returns status codes with number of their occurrences in format accepted
+// by ParseStatusCodeCounts, ordered by status code. Status codes without occurrences are omitted.
+func FormatStatusCodeCounts(counts map[int]int) string {
+	codes := make([]int, 0, len(counts))
+	for code, count := range counts {
+		if count > 0 {
+			codes = append(codes, code)
+		}
+	}
+
+	sort.Ints(codes)
+	entries := make([]string, 0, len(codes))
+	for _, code := range codes {
+		entries = append(entries, fmt.Sprintf("%d: %d", code, counts[code]))
+	}
+
+	return strings.Join(entries, ", ")
+}
@@ -0,0 +1,73 @@
+package httpctx
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsOfStatusClass(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    int
+		class   string
+		want    bool
+		wantErr bool
+	}{
+		{name: "success", code: 204, class: "2xx", want: true},
+		{name: "upper case class", code: 404, class: " 4XX ", want: true},
+		{name: "different class", code: 500, class: "2xx", want: false},
+		{name: "code below class", code: 99, class: "1xx", want: false},
+		{name: "invalid class digit", code: 600, class: "6xx", wantErr: true},
+		{name: "invalid class format", code: 200, class: "200", wantErr: true},
+		{name: "empty class", code: 200, class: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsOfStatusClass(tt.code, tt.class)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("IsOfStatusClass() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("IsOfStatusClass() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseStatusCodeCounts(t *testing.T) {
+	tests := []struct {
+		name    string
+		counts  string
+		want    map[int]int
+		wantErr bool
+	}{
+		{name: "empty", counts: "", want: map[int]int{}},
+		{name: "counts", counts: " 201: 1, 409:19,", want: map[int]int{201: 1, 409: 19}},
+		{name: "zero count", counts: "500: 0", want: map[int]int{500: 0}},
+		{name: "missing colon", counts: "201 1", wantErr: true},
+		{name: "invalid code", counts: "2xx: 1", wantErr: true},
+		{name: "code out of range", counts: "600: 1", wantErr: true},
+		{name: "negative count", counts: "201: -1", wantErr: true},
+		{name: "duplicated code", counts: "201: 1, 201: 2", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseStatusCodeCounts(tt.counts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseStatusCodeCounts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseStatusCodeCounts() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatStatusCodeCounts(t *testing.T) {
+	got := FormatStatusCodeCounts(map[int]int{409: 19, 201: 1, 500: 0})
+	if want := "201: 1, 409: 19"; got != want {
+		t.Errorf("FormatStatusCodeCounts() got = %s, want %s", got, want)
+	}
+}
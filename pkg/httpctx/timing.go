@@ -0,0 +1,165 @@
+package httpctx
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/http/httptrace"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Timing is breakdown of time spent on phases of HTTP(s) request. Phases that did not happen, for example
+// DNSLookup for IP address or TLSHandshake for reused connection, are 0. When request was redirected,
+// phases describe last request of redirect chain.
+type Timing struct {
+	// DNSLookup is time of resolving host name.
+	DNSLookup time.Duration
+
+	// Connect is time of establishing TCP connection.
+	Connect time.Duration
+
+	// TLSHandshake is time of TLS handshake.
+	TLSHandshake time.Duration
+
+	// ServerProcessing is time between writing request and receiving first byte of response.
+	ServerProcessing time.Duration
+
+	// TimeToFirstByte is time between start of request and receiving first byte of response.
+	TimeToFirstByte time.Duration
+
+	// BodyDownload is time between receiving first byte of response and reading whole response body.
+	BodyDownload time.Duration
+
+	// Total is time between start of request and reading whole response body.
+	Total time.Duration
+
+	// ConnectionReused tells whether request was sent over previously established connection.
+	ConnectionReused bool
+}
+
+// timingPhases are names of Timing phases accepted by Phase method.
+var timingPhases = []string{"DNSLookup", "Connect", "TLSHandshake", "ServerProcessing", "TimeToFirstByte", "BodyDownload", "Total"}
+
+// Phase returns duration of phase by its case-insensitive name, for example: "TLSHandshake" or "ServerProcessing".
+func (t Timing) Phase(name string) (time.Duration, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "dnslookup":
+		return t.DNSLookup, nil
+	case "connect":
+		return t.Connect, nil
+	case "tlshandshake":
+		return t.TLSHandshake, nil
+	case "serverprocessing":
+		return t.ServerProcessing, nil
+	case "timetofirstbyte":
+		return t.TimeToFirstByte, nil
+	case "bodydownload":
+		return t.BodyDownload, nil
+	case "total":
+		return t.Total, nil
+	default:
+		return 0, fmt.Errorf("invalid timing phase: '%s', expected one of: %s", name, strings.Join(timingPhases, ", "))
+	}
+}
+
+// String returns timing in human readable form.
+func (t Timing) String() string {
+	return fmt.Sprintf("DNS lookup: %s, connect: %s, TLS handshake: %s, server processing: %s, time to first byte: %s, body download: %s, total: %s, connection reused: %t",
+		t.DNSLookup, t.Connect, t.TLSHandshake, t.ServerProcessing, t.TimeToFirstByte, t.BodyDownload, t.Total, t.ConnectionReused)
+}
+
+// TimingTracer records Timing of single HTTP(s) request with net/http/httptrace.
+type TimingTracer struct {
+	mu sync.Mutex
+
+	start, done               time.Time
+	dnsStart, dnsDone         time.Time
+	connectStart, connectDone time.Time
+	tlsStart, tlsDone         time.Time
+	wroteRequest, firstByte   time.Time
+	reused                    bool
+}
+
+// TraceTiming returns copy of request instrumented with TimingTracer. Tracer starts measuring time immediately,
+// so request should be sent right after, and Done should be called when whole response body is read.
+func TraceTiming(req *http.Request) (*http.Request, *TimingTracer) {
+	tracer := &TimingTracer{start: time.Now()}
+	trace := &httptrace.ClientTrace{
+		GetConn: func(string) {
+			tracer.record(func() {
+				tracer.dnsStart, tracer.dnsDone, tracer.connectStart, tracer.connectDone = time.Time{}, time.Time{}, time.Time{}, time.Time{}
+				tracer.tlsStart, tracer.tlsDone, tracer.wroteRequest, tracer.firstByte = time.Time{}, time.Time{}, time.Time{}, time.Time{}
+			})
+		},
+		DNSStart: func(httptrace.DNSStartInfo) { tracer.record(func() { tracer.dnsStart = time.Now() }) },
+		DNSDone:  func(httptrace.DNSDoneInfo) { tracer.record(func() { tracer.dnsDone = time.Now() }) },
+		ConnectStart: func(string, string) {
+			tracer.record(func() {
+				if tracer.connectStart.IsZero() {
+					tracer.connectStart = time.Now()
+				}
+			})
+		},
+		ConnectDone: func(_, _ string, err error) {
+			tracer.record(func() {
+				if err == nil {
+					tracer.connectDone = time.Now()
+				}
+			})
+		},
+		TLSHandshakeStart: func() { tracer.record(func() { tracer.tlsStart = time.Now() }) },
+		TLSHandshakeDone:  func(tls.ConnectionState, error) { tracer.record(func() { tracer.tlsDone = time.Now() }) },
+		GotConn:           func(info httptrace.GotConnInfo) { tracer.record(func() { tracer.reused = info.Reused }) },
+		WroteRequest:      func(httptrace.WroteRequestInfo) { tracer.record(func() { tracer.wroteRequest = time.Now() }) },
+		GotFirstResponseByte: func() {
+			tracer.record(func() { tracer.firstByte = time.Now() })
+		},
+	}
+
+	return req.WithContext(httptrace.WithClientTrace(req.Context(), trace)), tracer
+}
+
+// Done marks moment when whole response body was read.
+func (t *TimingTracer) Done() {
+	t.record(func() { t.done = time.Now() })
+}
+
+// Timing returns recorded Timing. When Done was not called, Total and BodyDownload are measured until now.
+func (t *TimingTracer) Timing() Timing {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	done := t.done
+	if done.IsZero() {
+		done = time.Now()
+	}
+
+	return Timing{
+		DNSLookup:        between(t.dnsStart, t.dnsDone),
+		Connect:          between(t.connectStart, t.connectDone),
+		TLSHandshake:     between(t.tlsStart, t.tlsDone),
+		ServerProcessing: between(t.wroteRequest, t.firstByte),
+		TimeToFirstByte:  between(t.start, t.firstByte),
+		BodyDownload:     between(t.firstByte, done),
+		Total:            done.Sub(t.start),
+		ConnectionReused: t.reused,
+	}
+}
+
+func (t *TimingTracer) record(f func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	f()
+}
+
+// between returns time between from and to or 0, when any of them was not recorded.
+func between(from, to time.Time) time.Duration {
+	if from.IsZero() || to.IsZero() {
+		return 0
+	}
+
+	return to.Sub(from)
+}
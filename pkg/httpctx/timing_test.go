@@ -0,0 +1,68 @@
+package httpctx
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTraceTiming(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(20 * time.Millisecond)
+		w.Write([]byte("first part "))
+		w.(http.Flusher).Flush()
+		time.Sleep(20 * time.Millisecond)
+		w.Write([]byte("second part"))
+	}))
+	defer server.Close()
+
+	client := server.Client()
+	send := func() Timing {
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		tracedReq, tracer := TraceTiming(req)
+		resp, err := client.Do(tracedReq)
+		if err != nil {
+			t.Fatalf("Do() error = %v", err)
+		}
+
+		io.ReadAll(resp.Body)
+		resp.Body.Close()
+		tracer.Done()
+
+		return tracer.Timing()
+	}
+
+	first := send()
+	if first.ConnectionReused || first.Connect <= 0 || first.TLSHandshake <= 0 {
+		t.Errorf("first request should establish connection, got %s", first)
+	}
+
+	if first.ServerProcessing < 20*time.Millisecond || first.BodyDownload < 20*time.Millisecond {
+		t.Errorf("server processing and body download should take at least 20ms, got %s", first)
+	}
+
+	if first.TimeToFirstByte < first.ServerProcessing+first.TLSHandshake || first.Total < first.TimeToFirstByte+first.BodyDownload {
+		t.Errorf("phases are not consistent, got %s", first)
+	}
+
+	second := send()
+	if !second.ConnectionReused || second.Connect != 0 || second.TLSHandshake != 0 || second.DNSLookup != 0 {
+		t.Errorf("second request should reuse connection, got %s", second)
+	}
+}
+
+func TestTiming_Phase(t *testing.T) {
+	timing := Timing{DNSLookup: 1, Connect: 2, TLSHandshake: 3, ServerProcessing: 4, TimeToFirstByte: 5, BodyDownload: 6, Total: 7}
+
+	for name, want := range map[string]time.Duration{"DNSLookup": 1, "connect": 2, "TLSHANDSHAKE": 3, " ServerProcessing ": 4, "TimeToFirstByte": 5, "bodyDownload": 6, "Total": 7} {
+		if got, err := timing.Phase(name); err != nil || got != want {
+			t.Errorf("Phase(%q) got = %v, err = %v, want %v", name, got, err, want)
+		}
+	}
+
+	if _, err := timing.Phase("TLS"); err == nil {
+		t.Errorf("Phase() expected error for unknown phase")
+	}
+}
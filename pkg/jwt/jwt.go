@@ -0,0 +1,282 @@
+// Package jwt holds utilities for decoding JSON Web Tokens and verifying their signatures.
+package jwt
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// ErrInvalidSignature is returned when signature of token could not be verified with provided key.
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// Token is decoded JSON Web Token in JWS compact serialization.
+// Numbers of Header and Claims are decoded as json.Number.
+type Token struct {
+	// Raw is encoded token.
+	Raw string
+
+	// Header holds members of JOSE header, for example: alg, kid.
+	Header map[string]any
+
+	// Claims holds claims of token, for example: sub, exp.
+	Claims map[string]any
+
+	signingInput string
+	signature    []byte
+}
+
+// Decode decodes token in JWS compact serialization, for example: xxxxx.yyyyy.zzzzz.
+// Signature is not verified.
+func Decode(raw string) (Token, error) {
+	raw = strings.TrimSpace(raw)
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return Token{}, fmt.Errorf("token should consist of 3 parts separated by dots, got %d", len(parts))
+	}
+
+	header, err := decodeSegment(parts[0])
+	if err != nil {
+		return Token{}, fmt.Errorf("could not decode token header, err: %w", err)
+	}
+
+	claims, err := decodeSegment(parts[1])
+	if err != nil {
+		return Token{}, fmt.Errorf("could not decode token claims, err: %w", err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return Token{}, fmt.Errorf("could not decode token signature, err: %w", err)
+	}
+
+	return Token{
+		Raw:          raw,
+		Header:       header,
+		Claims:       claims,
+		signingInput: parts[0] + "." + parts[1],
+		signature:    signature,
+	}, nil
+}
+
+func decodeSegment(segment string) (map[string]any, error) {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var members map[string]any
+	if err = decoder.Decode(&members); err != nil {
+		return nil, err
+	}
+
+	if members == nil {
+		return nil, errors.New("segment should be JSON object")
+	}
+
+	return members, nil
+}
+
+// Algorithm returns value of alg header member.
+func (t Token) Algorithm() string {
+	alg, _ := t.Header["alg"].(string)
+
+	return alg
+}
+
+// KeyID returns value of kid header member or empty string.
+func (t Token) KeyID() string {
+	kid, _ := t.Header["kid"].(string)
+
+	return kid
+}
+
+// Time returns value of claim holding NumericDate, for example: exp, nbf or iat.
+func (t Token) Time(claim string) (time.Time, error) {
+	value, ok := t.Claims[claim]
+	if !ok {
+		return time.Time{}, fmt.Errorf("token does not have claim '%s'", claim)
+	}
+
+	number, ok := value.(json.Number)
+	if !ok {
+		return time.Time{}, fmt.Errorf("token claim '%s' should be number, got %v", claim, value)
+	}
+
+	seconds, err := number.Float64()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("token claim '%s' is not valid number, err: %w", claim, err)
+	}
+
+	whole, fraction := math.Modf(seconds)
+
+	return time.Unix(int64(whole), int64(fraction*float64(time.Second))), nil
+}
+
+// VerifyHMAC verifies signature of token signed with HS256, HS384 or HS512 algorithm using secret.
+func (t Token) VerifyHMAC(secret []byte) error {
+	if !strings.HasPrefix(t.Algorithm(), "HS") {
+		return fmt.Errorf("token algorithm '%s' is not HMAC algorithm", t.Algorithm())
+	}
+
+	return t.verify(secret)
+}
+
+// VerifyPEM verifies signature of token using public key or certificate in PEM format.
+// Supported are RSA (RS*, PS*), ECDSA (ES*) and Ed25519 (EdDSA) keys.
+func (t Token) VerifyPEM(data []byte) error {
+	key, err := ParsePEMPublicKey(data)
+	if err != nil {
+		return err
+	}
+
+	return t.verify(key)
+}
+
+// VerifyJWKS verifies signature of token using keys from JSON Web Key Set. When token header has kid member,
+// only key with the same kid is used, otherwise token is valid, if it is verified by any of keys.
+func (t Token) VerifyJWKS(data []byte) error {
+	keys, err := ParseJWKS(data)
+	if err != nil {
+		return err
+	}
+
+	kid := t.KeyID()
+	matched := 0
+	for _, key := range keys {
+		if kid != "" && key.KeyID != kid {
+			continue
+		}
+
+		matched++
+		if err = t.verify(key.Key); err == nil {
+			return nil
+		}
+	}
+
+	if matched == 0 {
+		return fmt.Errorf("JWKS does not have key with kid '%s'", kid)
+	}
+
+	return ErrInvalidSignature
+}
+
+// verify verifies signature of token with key appropriate to its algorithm:
+// []byte for HS*, *rsa.PublicKey for RS* and PS*, *ecdsa.PublicKey for ES* and ed25519.PublicKey for EdDSA.
+func (t Token) verify(key any) error {
+	alg := t.Algorithm()
+	if alg == "EdDSA" {
+		pub, ok := key.(ed25519.PublicKey)
+		if !ok {
+			return fmt.Errorf("key of type %T can't be used with algorithm %s", key, alg)
+		}
+
+		if !ed25519.Verify(pub, []byte(t.signingInput), t.signature) {
+			return ErrInvalidSignature
+		}
+
+		return nil
+	}
+
+	hash, err := algorithmHash(alg)
+	if err != nil {
+		return err
+	}
+
+	hasher := hash.New()
+	hasher.Write([]byte(t.signingInput))
+	digest := hasher.Sum(nil)
+
+	switch alg[:2] {
+	case "HS":
+		secret, ok := key.([]byte)
+		if !ok {
+			return fmt.Errorf("key of type %T can't be used with algorithm %s", key, alg)
+		}
+
+		mac := hmac.New(hash.New, secret)
+		mac.Write([]byte(t.signingInput))
+		if !hmac.Equal(mac.Sum(nil), t.signature) {
+			return ErrInvalidSignature
+		}
+	case "RS", "PS":
+		pub, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("key of type %T can't be used with algorithm %s", key, alg)
+		}
+
+		if alg[0] == 'R' {
+			err = rsa.VerifyPKCS1v15(pub, hash, digest, t.signature)
+		} else {
+			err = rsa.VerifyPSS(pub, hash, digest, t.signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		}
+
+		if err != nil {
+			return ErrInvalidSignature
+		}
+	case "ES":
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("key of type %T can't be used with algorithm %s", key, alg)
+		}
+
+		if curve := pub.Curve.Params().Name; curve != algorithmCurves[alg] {
+			return fmt.Errorf("key with curve %s can't be used with algorithm %s, expected curve %s", curve, alg, algorithmCurves[alg])
+		}
+
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if len(t.signature) != 2*size {
+			return ErrInvalidSignature
+		}
+
+		r := new(big.Int).SetBytes(t.signature[:size])
+		s := new(big.Int).SetBytes(t.signature[size:])
+		if !ecdsa.Verify(pub, digest, r, s) {
+			return ErrInvalidSignature
+		}
+	}
+
+	return nil
+}
+
+// algorithmCurves maps ECDSA JWS algorithms to names of elliptic curves they require.
+var algorithmCurves = map[string]string{
+	"ES256": "P-256",
+	"ES384": "P-384",
+	"ES512": "P-521",
+}
+
+// algorithmHash returns hash function used by JWS algorithm.
+func algorithmHash(alg string) (crypto.Hash, error) {
+	if len(alg) == 5 {
+		switch alg[:2] {
+		case "HS", "RS", "PS", "ES":
+			switch alg[2:] {
+			case "256":
+				return crypto.SHA256, nil
+			case "384":
+				return crypto.SHA384, nil
+			case "512":
+				return crypto.SHA512, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("unsupported token algorithm: '%s'", alg)
+}
@@ -0,0 +1,206 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func sign(t *testing.T, header, claims map[string]any, key any) string {
+	t.Helper()
+
+	headerData, _ := json.Marshal(header)
+	claimsData, _ := json.Marshal(claims)
+	input := base64.RawURLEncoding.EncodeToString(headerData) + "." + base64.RawURLEncoding.EncodeToString(claimsData)
+
+	alg := header["alg"].(string)
+	var signature []byte
+	var err error
+	switch k := key.(type) {
+	case []byte:
+		hash, _ := algorithmHash(alg)
+		mac := hmac.New(hash.New, k)
+		mac.Write([]byte(input))
+		signature = mac.Sum(nil)
+	case *rsa.PrivateKey:
+		hash, _ := algorithmHash(alg)
+		h := hash.New()
+		h.Write([]byte(input))
+		if alg[0] == 'P' {
+			signature, err = rsa.SignPSS(rand.Reader, k, hash, h.Sum(nil), &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		} else {
+			signature, err = rsa.SignPKCS1v15(rand.Reader, k, hash, h.Sum(nil))
+		}
+	case *ecdsa.PrivateKey:
+		hash, _ := algorithmHash(alg)
+		h := hash.New()
+		h.Write([]byte(input))
+		r, s, signErr := ecdsa.Sign(rand.Reader, k, h.Sum(nil))
+		err = signErr
+		size := (k.Curve.Params().BitSize + 7) / 8
+		signature = make([]byte, 2*size)
+		r.FillBytes(signature[:size])
+		s.FillBytes(signature[size:])
+	case ed25519.PrivateKey:
+		signature = ed25519.Sign(k, []byte(input))
+	}
+
+	if err != nil {
+		t.Fatalf("could not sign token, err: %v", err)
+	}
+
+	return input + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func pemPublicKey(t *testing.T, key crypto.PublicKey) []byte {
+	t.Helper()
+
+	data, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatalf("could not marshal public key, err: %v", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: data})
+}
+
+func TestDecode(t *testing.T) {
+	raw := sign(t, map[string]any{"alg": "HS256", "typ": "JWT", "kid": "k1"}, map[string]any{"sub": "user", "exp": 1700000000.5}, []byte("secret"))
+
+	token, err := Decode(" " + raw + "\n")
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+
+	if token.Algorithm() != "HS256" || token.KeyID() != "k1" || token.Claims["sub"] != "user" || token.Raw != raw {
+		t.Errorf("Decode() got = %+v", token)
+	}
+
+	exp, err := token.Time("exp")
+	if err != nil || !exp.Equal(time.Unix(1700000000, int64(time.Second/2))) {
+		t.Errorf("Time() got = %v, err = %v", exp, err)
+	}
+
+	if _, err = token.Time("sub"); err == nil {
+		t.Errorf("Time() expected error for claim that is not number")
+	}
+
+	if _, err = token.Time("nbf"); err == nil {
+		t.Errorf("Time() expected error for missing claim")
+	}
+
+	for _, invalid := range []string{"", "a.b", "a.b.c", "e30.bm90LWpzb24.", "e30.W10."} {
+		if _, err = Decode(invalid); err == nil {
+			t.Errorf("Decode(%q) expected error", invalid)
+		}
+	}
+}
+
+func TestToken_VerifyHMAC(t *testing.T) {
+	for _, alg := range []string{"HS256", "HS384", "HS512"} {
+		token, _ := Decode(sign(t, map[string]any{"alg": alg}, map[string]any{"sub": "user"}, []byte("secret")))
+		if err := token.VerifyHMAC([]byte("secret")); err != nil {
+			t.Errorf("%s: VerifyHMAC() error = %v", alg, err)
+		}
+
+		if err := token.VerifyHMAC([]byte("other")); !errors.Is(err, ErrInvalidSignature) {
+			t.Errorf("%s: VerifyHMAC() error = %v, want %v", alg, err, ErrInvalidSignature)
+		}
+	}
+
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	token, _ := Decode(sign(t, map[string]any{"alg": "RS256"}, map[string]any{}, rsaKey))
+	if err := token.VerifyHMAC([]byte("secret")); err == nil {
+		t.Errorf("VerifyHMAC() expected error for RSA token")
+	}
+}
+
+func TestToken_VerifyPEM(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	edPub, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	tests := []struct {
+		alg     string
+		key     any
+		pem     []byte
+		wantErr bool
+	}{
+		{alg: "RS256", key: rsaKey, pem: pemPublicKey(t, &rsaKey.PublicKey)},
+		{alg: "PS384", key: rsaKey, pem: pemPublicKey(t, &rsaKey.PublicKey)},
+		{alg: "ES256", key: ecKey, pem: pemPublicKey(t, &ecKey.PublicKey)},
+		{alg: "EdDSA", key: edKey, pem: pemPublicKey(t, edPub)},
+		{alg: "RS512", key: rsaKey, pem: pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey)})},
+		{alg: "ES256", key: ecKey, pem: pemPublicKey(t, &otherKey.PublicKey), wantErr: true},
+		{alg: "ES384", key: ecKey, pem: pemPublicKey(t, &ecKey.PublicKey), wantErr: true},
+		{alg: "RS256", key: rsaKey, pem: pemPublicKey(t, &ecKey.PublicKey), wantErr: true},
+		{alg: "RS256", key: rsaKey, pem: []byte("not PEM"), wantErr: true},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d %s", i, tt.alg), func(t *testing.T) {
+			token, err := Decode(sign(t, map[string]any{"alg": tt.alg}, map[string]any{"sub": "user"}, tt.key))
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+
+			if err = token.VerifyPEM(tt.pem); (err != nil) != tt.wantErr {
+				t.Errorf("VerifyPEM() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestToken_VerifyJWKS(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	edPub, edKey, _ := ed25519.GenerateKey(rand.Reader)
+
+	b64 := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+	jwks := []byte(fmt.Sprintf(`{"keys": [
+		{"kty": "RSA", "kid": "rsa", "n": "%s", "e": "%s"},
+		{"kty": "EC", "kid": "ec", "crv": "P-256", "x": "%s", "y": "%s"},
+		{"kty": "OKP", "crv": "Ed25519", "x": "%s"}
+	]}`, b64(rsaKey.N.Bytes()), b64([]byte{1, 0, 1}), b64(ecKey.X.Bytes()), b64(ecKey.Y.Bytes()), b64(edPub)))
+	withUnsupported := []byte(fmt.Sprintf(`{"keys": [
+		{"kty": "oct", "kid": "hmac", "k": "c2VjcmV0"},
+		{"kty": "EC", "kid": "secp256k1", "crv": "secp256k1", "x": "%s", "y": "%s"},
+		{"kty": "unknown"},
+		{"kty": "RSA", "kid": "rsa", "n": "%s", "e": "%s"}
+	]}`, b64(ecKey.X.Bytes()), b64(ecKey.Y.Bytes()), b64(rsaKey.N.Bytes()), b64([]byte{1, 0, 1})))
+
+	tests := []struct {
+		name    string
+		header  map[string]any
+		key     any
+		jwks    []byte
+		wantErr bool
+	}{
+		{name: "RSA key selected by kid", header: map[string]any{"alg": "RS256", "kid": "rsa"}, key: rsaKey, jwks: jwks},
+		{name: "EC key selected by kid", header: map[string]any{"alg": "ES256", "kid": "ec"}, key: ecKey, jwks: jwks},
+		{name: "key without kid", header: map[string]any{"alg": "EdDSA"}, key: edKey, jwks: jwks},
+		{name: "kid of different key", header: map[string]any{"alg": "RS256", "kid": "ec"}, key: rsaKey, jwks: jwks, wantErr: true},
+		{name: "unknown kid", header: map[string]any{"alg": "RS256", "kid": "other"}, key: rsaKey, jwks: jwks, wantErr: true},
+		{name: "unsupported keys skipped", header: map[string]any{"alg": "RS256", "kid": "rsa"}, key: rsaKey, jwks: withUnsupported},
+		{name: "invalid JWKS", header: map[string]any{"alg": "RS256"}, key: rsaKey, jwks: []byte(`{"keys": [{"kty": "oct"}]}`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, _ := Decode(sign(t, tt.header, map[string]any{"sub": "user"}, tt.key))
+			if err := token.VerifyJWKS(tt.jwks); (err != nil) != tt.wantErr {
+				t.Errorf("VerifyJWKS() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// JWK is public key obtained from JSON Web Key Set.
+type JWK struct {
+	// KeyID is value of kid member of key.
+	KeyID string
+
+	// Key is *rsa.PublicKey, *ecdsa.PublicKey or ed25519.PublicKey.
+	Key any
+}
+
+// jwk is JSON representation of public key, members are described in RFC 7517, 7518 and 8037.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// ParsePEMPublicKey returns public key from first block of data in PEM format.
+// Block may be PUBLIC KEY, RSA PUBLIC KEY or CERTIFICATE.
+func ParsePEMPublicKey(data []byte) (any, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("could not find PEM block in provided data")
+	}
+
+	switch block.Type {
+	case "PUBLIC KEY":
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse public key, err: %w", err)
+		}
+
+		return key, nil
+	case "RSA PUBLIC KEY":
+		key, err := x509.ParsePKCS1PublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse RSA public key, err: %w", err)
+		}
+
+		return key, nil
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse certificate, err: %w", err)
+		}
+
+		return cert.PublicKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type: %s", block.Type)
+	}
+}
+
+// ParseJWKS returns public keys from JSON Web Key Set. Supported are RSA, EC and OKP (Ed25519) keys,
+// other keys, for example symmetric ones, are skipped. Error is returned when set has no supported key.
+func ParseJWKS(data []byte) ([]JWK, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("could not decode JWKS, err: %w", err)
+	}
+
+	keys := make([]JWK, 0, len(set.Keys))
+	skipped := make([]string, 0)
+	for i, k := range set.Keys {
+		key, err := k.publicKey()
+		if err != nil {
+			skipped = append(skipped, fmt.Sprintf("key %d: %s", i, err))
+			continue
+		}
+
+		keys = append(keys, JWK{KeyID: k.Kid, Key: key})
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("JWKS does not have any supported key, skipped: %s", strings.Join(skipped, ", "))
+	}
+
+	return keys, nil
+}
+
+func (k jwk) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid member n, err: %w", err)
+		}
+
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid member e, err: %w", err)
+		}
+
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve: '%s'", k.Crv)
+		}
+
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid member x, err: %w", err)
+		}
+
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid member y, err: %w", err)
+		}
+
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve: '%s'", k.Crv)
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid member x")
+		}
+
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type: '%s'", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return nil, errors.New("value is empty")
+	}
+
+	return new(big.Int).SetBytes(data), nil
+}
@@ -0,0 +1,251 @@
+// Package load holds utilities for sending requests according to load profile and summarizing their latencies.
+package load
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Duration is time.Duration, which may be deserialized from JSON or YAML string, for example: "10s", "1m30s".
+type Duration time.Duration
+
+// UnmarshalJSON deserializes duration from JSON string.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("duration should be string, for example: \"10s\", err: %w", err)
+	}
+
+	return d.parse(s)
+}
+
+// UnmarshalYAML deserializes duration from YAML string.
+func (d *Duration) UnmarshalYAML(unmarshal func(any) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return fmt.Errorf("duration should be string, for example: 10s, err: %w", err)
+	}
+
+	return d.parse(s)
+}
+
+func (d *Duration) parse(s string) error {
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+
+	*d = Duration(duration)
+
+	return nil
+}
+
+// maxRate is maximal Profile.Rate, for which interval between requests is at least 1 nanosecond.
+const maxRate = float64(time.Second)
+
+// Profile describes how requests are sent. At least one of Duration and Iterations should be set,
+// when both are set, sending stops when any of them is reached.
+type Profile struct {
+	// Concurrency is number of requests that may be in progress at the same time, default is 1.
+	Concurrency int `json:"concurrency" yaml:"concurrency"`
+
+	// Rate is number of requests started per second, 0 means as many as Concurrency allows, at most 1e9.
+	// Rate is best-effort: requests are started only when one of Concurrency workers is free, so when
+	// responses are slow, fewer requests are sent and latency does not include time spent waiting for worker.
+	Rate float64 `json:"rate" yaml:"rate"`
+
+	// Duration is time during which requests are sent.
+	Duration Duration `json:"duration" yaml:"duration"`
+
+	// Iterations is total number of requests.
+	Iterations int `json:"iterations" yaml:"iterations"`
+}
+
+// Validate checks whether profile is complete.
+func (p Profile) Validate() error {
+	if p.Concurrency < 0 || p.Rate < 0 || p.Duration < 0 || p.Iterations < 0 {
+		return errors.New("load profile values should not be negative")
+	}
+
+	if p.Rate > maxRate {
+		return fmt.Errorf("load profile rate should not be greater than %g requests per second", maxRate)
+	}
+
+	if p.Duration == 0 && p.Iterations == 0 {
+		return errors.New("load profile should have duration or iterations")
+	}
+
+	return nil
+}
+
+// Summary describes results of requests sent according to load profile.
+type Summary struct {
+	// Requests is number of sent requests.
+	Requests int
+
+	// Errors is number of requests that failed or were answered with status code 400 or greater.
+	Errors int
+
+	// ErrorRate is fraction of requests counted in Errors, from 0 to 1.
+	ErrorRate float64
+
+	// Throughput is number of requests completed per second.
+	Throughput float64
+
+	// Elapsed is time between start of first request and end of last one.
+	Elapsed time.Duration
+
+	// StatusCodes holds number of occurrences of every received status code.
+	StatusCodes map[int]int
+
+	// Min, Mean and Max are minimal, average and maximal latency.
+	Min, Mean, Max time.Duration
+
+	// P50, P95 and P99 are 50th, 95th and 99th percentile of latencies.
+	P50, P95, P99 time.Duration
+
+	// Latencies are latencies of all requests in ascending order.
+	Latencies []time.Duration `json:"-" yaml:"-"`
+}
+
+// Percentile returns latency below or equal to which are p percent of latencies, using nearest-rank method.
+func (s Summary) Percentile(p float64) (time.Duration, error) {
+	if p <= 0 || p > 100 {
+		return 0, fmt.Errorf("percentile should be greater than 0 and not greater than 100, got %v", p)
+	}
+
+	if len(s.Latencies) == 0 {
+		return 0, errors.New("summary does not have any latencies")
+	}
+
+	rank := int(math.Ceil(p / 100 * float64(len(s.Latencies))))
+
+	return s.Latencies[rank-1], nil
+}
+
+// String returns summary in human readable form.
+func (s Summary) String() string {
+	return fmt.Sprintf("requests: %d, errors: %d (%.2f%%), throughput: %.2f req/s, latency min: %s, mean: %s, p50: %s, p95: %s, p99: %s, max: %s",
+		s.Requests, s.Errors, s.ErrorRate*100, s.Throughput, s.Min, s.Mean, s.P50, s.P95, s.P99, s.Max)
+}
+
+// Run calls send according to profile and summarizes results. send should send single request and return
+// its status code or error.
+func Run(profile Profile, send func() (int, error)) (Summary, error) {
+	if err := profile.Validate(); err != nil {
+		return Summary{}, err
+	}
+
+	concurrency := profile.Concurrency
+	if concurrency == 0 {
+		concurrency = 1
+	}
+
+	tokens := make(chan struct{})
+	stop := make(chan struct{})
+	go dispatch(profile, tokens, stop)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	latencies := make([]time.Duration, 0)
+	summary := Summary{StatusCodes: make(map[int]int)}
+	start := time.Now()
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range tokens {
+				requestStart := time.Now()
+				code, err := send()
+				latency := time.Since(requestStart)
+
+				mu.Lock()
+				latencies = append(latencies, latency)
+				if err != nil || code >= 400 {
+					summary.Errors++
+				}
+
+				if err == nil {
+					summary.StatusCodes[code]++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(stop)
+	summary.Elapsed = time.Since(start)
+	summarize(&summary, latencies)
+
+	return summary, nil
+}
+
+// dispatch sends tokens allowing to start requests, according to profile, and closes tokens when profile is exhausted.
+func dispatch(profile Profile, tokens chan<- struct{}, stop <-chan struct{}) {
+	defer close(tokens)
+
+	var deadline <-chan time.Time
+	if profile.Duration > 0 {
+		timer := time.NewTimer(time.Duration(profile.Duration))
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	var tick <-chan time.Time
+	if profile.Rate > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / profile.Rate))
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for sent := 0; profile.Iterations == 0 || sent < profile.Iterations; sent++ {
+		if tick != nil && sent > 0 {
+			select {
+			case <-tick:
+			case <-deadline:
+				return
+			case <-stop:
+				return
+			}
+		}
+
+		select {
+		case tokens <- struct{}{}:
+		case <-deadline:
+			return
+		case <-stop:
+			return
+		}
+	}
+}
+
+func summarize(summary *Summary, latencies []time.Duration) {
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	summary.Latencies = latencies
+	summary.Requests = len(latencies)
+	if summary.Requests == 0 {
+		return
+	}
+
+	var total time.Duration
+	for _, latency := range latencies {
+		total += latency
+	}
+
+	summary.Min = latencies[0]
+	summary.Max = latencies[len(latencies)-1]
+	summary.Mean = total / time.Duration(len(latencies))
+	summary.P50, _ = summary.Percentile(50)
+	summary.P95, _ = summary.Percentile(95)
+	summary.P99, _ = summary.Percentile(99)
+	summary.ErrorRate = float64(summary.Errors) / float64(summary.Requests)
+	if summary.Elapsed > 0 {
+		summary.Throughput = float64(summary.Requests) / summary.Elapsed.Seconds()
+	}
+}
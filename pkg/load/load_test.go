@@ -0,0 +1,162 @@
+package load
+
+import (
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+func TestProfile_Deserialize(t *testing.T) {
+	want := Profile{Concurrency: 4, Rate: 20.5, Duration: Duration(1500 * time.Millisecond), Iterations: 10}
+
+	var fromJSON Profile
+	if err := json.Unmarshal([]byte(`{"concurrency": 4, "rate": 20.5, "duration": "1.5s", "iterations": 10}`), &fromJSON); err != nil || fromJSON != want {
+		t.Errorf("json.Unmarshal() got = %+v, err = %v, want %+v", fromJSON, err, want)
+	}
+
+	var fromYAML Profile
+	if err := yaml.UnmarshalStrict([]byte("concurrency: 4\nrate: 20.5\nduration: 1.5s\niterations: 10"), &fromYAML); err != nil || fromYAML != want {
+		t.Errorf("yaml.UnmarshalStrict() got = %+v, err = %v, want %+v", fromYAML, err, want)
+	}
+
+	var invalid Profile
+	if err := json.Unmarshal([]byte(`{"duration": 10}`), &invalid); err == nil {
+		t.Errorf("json.Unmarshal() expected error for duration that is not string")
+	}
+
+	if err := json.Unmarshal([]byte(`{"duration": "10 seconds"}`), &invalid); err == nil {
+		t.Errorf("json.Unmarshal() expected error for invalid duration")
+	}
+}
+
+func TestProfile_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile Profile
+		wantErr bool
+	}{
+		{name: "iterations", profile: Profile{Iterations: 1}},
+		{name: "duration", profile: Profile{Duration: Duration(time.Second), Rate: 10, Concurrency: 2}},
+		{name: "neither duration nor iterations", profile: Profile{Concurrency: 2}, wantErr: true},
+		{name: "negative value", profile: Profile{Iterations: 1, Rate: -1}, wantErr: true},
+		{name: "max rate", profile: Profile{Iterations: 1, Rate: 1e9}},
+		{name: "rate too high", profile: Profile{Iterations: 1, Rate: 2e9}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.profile.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSummary_Percentile(t *testing.T) {
+	latencies := make([]time.Duration, 0, 100)
+	for i := 1; i <= 100; i++ {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+
+	summary := Summary{Latencies: latencies}
+	for p, want := range map[float64]time.Duration{1: time.Millisecond, 50: 50 * time.Millisecond, 99.5: 100 * time.Millisecond, 100: 100 * time.Millisecond} {
+		if got, err := summary.Percentile(p); err != nil || got != want {
+			t.Errorf("Percentile(%v) got = %s, err = %v, want %s", p, got, err, want)
+		}
+	}
+
+	for _, p := range []float64{0, 101} {
+		if _, err := summary.Percentile(p); err == nil {
+			t.Errorf("Percentile(%v) expected error", p)
+		}
+	}
+
+	if _, err := (Summary{}).Percentile(50); err == nil {
+		t.Errorf("Percentile() expected error for summary without latencies")
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Run("iterations with concurrency", func(t *testing.T) {
+		var calls, inProgress, maxInProgress int32
+		summary, err := Run(Profile{Concurrency: 3, Iterations: 20}, func() (int, error) {
+			n := atomic.AddInt32(&calls, 1)
+			current := atomic.AddInt32(&inProgress, 1)
+			for {
+				max := atomic.LoadInt32(&maxInProgress)
+				if current <= max || atomic.CompareAndSwapInt32(&maxInProgress, max, current) {
+					break
+				}
+			}
+
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&inProgress, -1)
+
+			switch {
+			case n%10 == 0:
+				return 0, errors.New("connection refused")
+			case n%5 == 0:
+				return 500, nil
+			default:
+				return 200, nil
+			}
+		})
+		if err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+
+		if calls != 20 || summary.Requests != 20 || len(summary.Latencies) != 20 {
+			t.Errorf("Run() called send %d times, summary has %d requests, want 20", calls, summary.Requests)
+		}
+
+		if maxInProgress > 3 {
+			t.Errorf("Run() had %d requests in progress, want at most 3", maxInProgress)
+		}
+
+		if summary.Errors != 4 || summary.ErrorRate != 0.2 || summary.StatusCodes[200] != 16 || summary.StatusCodes[500] != 2 {
+			t.Errorf("Run() got errors = %d, error rate = %v, status codes = %v", summary.Errors, summary.ErrorRate, summary.StatusCodes)
+		}
+
+		if summary.Min < time.Millisecond || summary.Min > summary.P50 || summary.P50 > summary.P95 || summary.P95 > summary.P99 ||
+			summary.P99 > summary.Max || summary.Mean < summary.Min || summary.Mean > summary.Max || summary.Throughput <= 0 {
+			t.Errorf("Run() got inconsistent summary: %s", summary)
+		}
+	})
+
+	t.Run("duration with rate", func(t *testing.T) {
+		var calls int32
+		summary, err := Run(Profile{Concurrency: 2, Rate: 100, Duration: Duration(200 * time.Millisecond)}, func() (int, error) {
+			atomic.AddInt32(&calls, 1)
+
+			return 204, nil
+		})
+		if err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+
+		if calls < 5 || calls > 25 || summary.Requests != int(calls) {
+			t.Errorf("Run() sent %d requests, want about 20", calls)
+		}
+
+		if summary.Errors != 0 || summary.StatusCodes[204] != int(calls) {
+			t.Errorf("Run() got errors = %d, status codes = %v", summary.Errors, summary.StatusCodes)
+		}
+	})
+
+	t.Run("iterations stop before duration", func(t *testing.T) {
+		start := time.Now()
+		summary, err := Run(Profile{Iterations: 3, Duration: Duration(time.Minute)}, func() (int, error) { return 200, nil })
+		if err != nil || summary.Requests != 3 || time.Since(start) > 10*time.Second {
+			t.Errorf("Run() got requests = %d, err = %v", summary.Requests, err)
+		}
+	})
+
+	t.Run("invalid profile", func(t *testing.T) {
+		if _, err := Run(Profile{}, func() (int, error) { return 200, nil }); err == nil {
+			t.Errorf("Run() expected error for invalid profile")
+		}
+	})
+}
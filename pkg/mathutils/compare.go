@@ -0,0 +1,69 @@
+package mathutils
+
+import (
+	"fmt"
+	"math"
+)
+
+// Operator represents numeric comparison operator.
+type Operator string
+
+const (
+	// OperatorGreater checks whether number is greater than argument.
+	OperatorGreater Operator = ">"
+
+	// OperatorGreaterOrEqual checks whether number is greater or equal to argument.
+	OperatorGreaterOrEqual Operator = ">="
+
+	// OperatorLess checks whether number is less than argument.
+	OperatorLess Operator = "<"
+
+	// OperatorLessOrEqual checks whether number is less or equal to argument.
+	OperatorLessOrEqual Operator = "<="
+
+	// OperatorBetween checks whether number belongs to closed range described by two arguments: lower and upper bound.
+	OperatorBetween Operator = "between"
+
+	// OperatorApprox checks whether number differs from first argument by no more than second argument (delta).
+	OperatorApprox Operator = "approx"
+)
+
+// Compare checks whether number satisfies operator with provided arguments.
+func Compare(number float64, operator Operator, args ...float64) (bool, error) {
+	switch operator {
+	case OperatorGreater, OperatorGreaterOrEqual, OperatorLess, OperatorLessOrEqual:
+		if len(args) != 1 {
+			return false, fmt.Errorf("operator '%s' requires exactly 1 argument, got %d", operator, len(args))
+		}
+	case OperatorBetween, OperatorApprox:
+		if len(args) != 2 {
+			return false, fmt.Errorf("operator '%s' requires exactly 2 arguments, got %d", operator, len(args))
+		}
+	default:
+		return false, fmt.Errorf("unknown operator '%s', available operators: %s, %s, %s, %s, %s, %s", operator,
+			OperatorGreater, OperatorGreaterOrEqual, OperatorLess, OperatorLessOrEqual, OperatorBetween, OperatorApprox)
+	}
+
+	switch operator {
+	case OperatorGreater:
+		return number > args[0], nil
+	case OperatorGreaterOrEqual:
+		return number >= args[0], nil
+	case OperatorLess:
+		return number < args[0], nil
+	case OperatorLessOrEqual:
+		return number <= args[0], nil
+	case OperatorBetween:
+		if args[0] > args[1] {
+			return false, fmt.Errorf("lower bound %v is greater than upper bound %v", args[0], args[1])
+		}
+
+		return number >= args[0] && number <= args[1], nil
+	default:
+		if args[1] < 0 {
+			return false, fmt.Errorf("delta %v should not be negative", args[1])
+		}
+
+		return math.Abs(number-args[0]) <= args[1], nil
+	}
+}
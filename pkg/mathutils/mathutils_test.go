@@ -49,3 +49,44 @@ func TestRandomFloat64(t *testing.T) {
 		}
 	}
 }
+
+func TestCompare(t *testing.T) {
+	type args struct {
+		number   float64
+		operator Operator
+		args     []float64
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{name: "unknown operator", args: args{number: 1, operator: "!=", args: []float64{1}}, wantErr: true},
+		{name: "missing argument", args: args{number: 1, operator: OperatorGreater}, wantErr: true},
+		{name: "too many arguments", args: args{number: 1, operator: OperatorBetween, args: []float64{1, 2, 3}}, wantErr: true},
+		{name: "inverted range", args: args{number: 1, operator: OperatorBetween, args: []float64{2, 1}}, wantErr: true},
+		{name: "negative delta", args: args{number: 1, operator: OperatorApprox, args: []float64{1, -1}}, wantErr: true},
+		{name: "greater", args: args{number: 2, operator: OperatorGreater, args: []float64{1}}, want: true},
+		{name: "not greater", args: args{number: 1, operator: OperatorGreater, args: []float64{1}}, want: false},
+		{name: "greater or equal", args: args{number: 1, operator: OperatorGreaterOrEqual, args: []float64{1}}, want: true},
+		{name: "less", args: args{number: 0.5, operator: OperatorLess, args: []float64{1}}, want: true},
+		{name: "not less or equal", args: args{number: 1.5, operator: OperatorLessOrEqual, args: []float64{1}}, want: false},
+		{name: "between", args: args{number: 2, operator: OperatorBetween, args: []float64{2, 3}}, want: true},
+		{name: "not between", args: args{number: 3.01, operator: OperatorBetween, args: []float64{2, 3}}, want: false},
+		{name: "approx", args: args{number: 9.995, operator: OperatorApprox, args: []float64{10, 0.01}}, want: true},
+		{name: "not approx", args: args{number: 9.98, operator: OperatorApprox, args: []float64{10, 0.01}}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Compare(tt.args.number, tt.args.operator, tt.args.args...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Compare() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("Compare() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
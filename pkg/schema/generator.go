@@ -0,0 +1,1114 @@
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pawelWritesCode/gdutils/pkg/httpctx"
+	"github.com/pawelWritesCode/gdutils/pkg/mathutils"
+	"github.com/pawelWritesCode/gdutils/pkg/osutils"
+	v "github.com/pawelWritesCode/gdutils/pkg/validator"
+)
+
+// GenerationMode describes which of values allowed by JSON schema should be picked by generator.
+type GenerationMode string
+
+const (
+	// GenerationModeRandom picks random values allowed by JSON schema.
+	GenerationModeRandom GenerationMode = "random"
+
+	// GenerationModeMinimal picks the smallest allowed values: only required properties,
+	// the shortest strings and arrays and the lowest numbers.
+	GenerationModeMinimal GenerationMode = "minimal"
+
+	// GenerationModeMaximal picks the biggest allowed values: all defined properties,
+	// the longest strings and arrays and the highest numbers.
+	GenerationModeMaximal GenerationMode = "maximal"
+)
+
+const (
+	// defaultStringSpread is number of characters added to minLength when string has no maxLength.
+	defaultStringSpread = 16
+
+	// defaultArraySpread is number of items added to minItems when array has no maxItems.
+	defaultArraySpread = 3
+
+	// defaultNumberSpread is width of range used when number has no minimum or maximum.
+	defaultNumberSpread = 1000
+
+	// softDepthLimit is nesting depth after which generator produces only required data.
+	softDepthLimit = 8
+
+	// hardDepthLimit is nesting depth after which generator gives up, schema is probably infinitely recursive.
+	hardDepthLimit = 64
+
+	// fetchTimeout is time limit of downloading schema document from URL.
+	fetchTimeout = 30 * time.Second
+
+	// maxAttempts is number of attempts to generate value satisfying constraints that can't be generated directly.
+	maxAttempts = 20
+)
+
+// JSONSchemaRawGenerator is entity that has ability to generate data valid against JSON schema passed as string.
+type JSONSchemaRawGenerator struct{}
+
+// JSONSchemaReferenceGenerator is entity that has ability to generate data valid against JSON schema passed as reference.
+type JSONSchemaReferenceGenerator struct {
+	fileValidator v.Validator
+	urlValidator  v.Validator
+
+	// schemasDir represents absolute path to JSON schemas directory.
+	schemasDir string
+}
+
+// NewJSONSchemaRawGenerator creates new JSONSchemaRawGenerator
+func NewJSONSchemaRawGenerator() JSONSchemaRawGenerator {
+	return JSONSchemaRawGenerator{}
+}
+
+// NewDefaultJSONSchemaReferenceGenerator creates new JSONSchemaReferenceGenerator with fixed services
+func NewDefaultJSONSchemaReferenceGenerator(schemasDir string) JSONSchemaReferenceGenerator {
+	return NewJSONSchemaReferenceGenerator(schemasDir, osutils.NewFileValidator(), httpctx.NewURLValidator())
+}
+
+// NewJSONSchemaReferenceGenerator creates new JSONSchemaReferenceGenerator with provided services
+func NewJSONSchemaReferenceGenerator(schemasDir string, fileValidator v.Validator, urlValidator v.Validator) JSONSchemaReferenceGenerator {
+	return JSONSchemaReferenceGenerator{
+		fileValidator: fileValidator,
+		urlValidator:  urlValidator,
+		schemasDir:    schemasDir,
+	}
+}
+
+// Generate generates data valid against jsonSchema.
+// Schema may contain $ref pointing at its own definitions or at absolute URL.
+func (g JSONSchemaRawGenerator) Generate(jsonSchema string, mode GenerationMode) (any, error) {
+	var doc any
+	if err := json.Unmarshal([]byte(jsonSchema), &doc); err != nil {
+		return nil, fmt.Errorf("could not parse JSON schema, err: %w", err)
+	}
+
+	gen, err := newGenerator(mode)
+	if err != nil {
+		return nil, err
+	}
+
+	gen.documents[""] = doc
+
+	return gen.generate(doc, "", 0)
+}
+
+// Generate generates data valid against JSON schema located in schemaPath.
+// schemaPath may be URL or relative/full path to json schema on user OS,
+// relative $ref are resolved against schemaPath.
+func (g JSONSchemaReferenceGenerator) Generate(schemaPath string, mode GenerationMode) (any, error) {
+	source, err := getSource(g.urlValidator, g.fileValidator, g.schemasDir, schemaPath)
+	if err != nil {
+		return nil, err
+	}
+
+	gen, err := newGenerator(mode)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := gen.document(source)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.generate(doc, source, 0)
+}
+
+// generator holds state of single generation process.
+type generator struct {
+	mode GenerationMode
+
+	// documents holds already loaded schema documents by their source.
+	documents map[string]any
+}
+
+func newGenerator(mode GenerationMode) (*generator, error) {
+	switch mode {
+	case "":
+		mode = GenerationModeRandom
+	case GenerationModeRandom, GenerationModeMinimal, GenerationModeMaximal:
+	default:
+		return nil, fmt.Errorf("unknown generation mode: %s, allowed: %s, %s, %s",
+			mode, GenerationModeRandom, GenerationModeMinimal, GenerationModeMaximal)
+	}
+
+	return &generator{mode: mode, documents: map[string]any{}}, nil
+}
+
+// generate generates value valid against schema node. base is source of document that holds node.
+func (g *generator) generate(node any, base string, depth int) (any, error) {
+	if depth > hardDepthLimit {
+		return nil, fmt.Errorf("schema nesting exceeded %d levels, schema is probably infinitely recursive", hardDepthLimit)
+	}
+
+	sch, err := g.resolve(node, base, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	if sch.schema == nil {
+		return randomString(1, defaultStringSpread), nil
+	}
+
+	if c, ok := sch.schema["const"]; ok {
+		return c, nil
+	}
+
+	if enum, ok := sch.schema["enum"].([]any); ok && len(enum) > 0 {
+		return enum[pickInRange(0, len(enum)-1, g.mode)], nil
+	}
+
+	for _, keyword := range []string{"anyOf", "oneOf"} {
+		options, ok := sch.schema[keyword].([]any)
+		if !ok || len(options) == 0 {
+			continue
+		}
+
+		option, err := g.resolve(options[pickInRange(0, len(options)-1, GenerationModeRandom)], sch.base, depth)
+		if err != nil {
+			return nil, err
+		}
+
+		rest := withoutKeywords(sch.schema, keyword)
+
+		return g.generate(mergeSchemas(rest, option.schema), option.base, depth+1)
+	}
+
+	switch g.pickType(sch.schema) {
+	case "object":
+		return g.generateObject(sch, depth)
+	case "array":
+		return g.generateArray(sch, depth)
+	case "integer":
+		return g.generateInteger(sch.schema)
+	case "number":
+		return g.generateNumber(sch.schema)
+	case "boolean":
+		switch g.mode {
+		case GenerationModeMinimal:
+			return false, nil
+		case GenerationModeMaximal:
+			return true, nil
+		default:
+			return mathutils.MustRandomInt(0, 1) == 1, nil
+		}
+	case "null":
+		return nil, nil
+	default:
+		return g.generateString(sch.schema)
+	}
+}
+
+// resolvedSchema is schema with resolved references and merged allOf.
+type resolvedSchema struct {
+	schema map[string]any
+	base   string
+}
+
+// resolve follows $ref and merges allOf of schema node. Returned schema is nil for "true" boolean schema.
+func (g *generator) resolve(node any, base string, depth int) (resolvedSchema, error) {
+	if depth > hardDepthLimit {
+		return resolvedSchema{}, fmt.Errorf("schema nesting exceeded %d levels, schema is probably infinitely recursive", hardDepthLimit)
+	}
+
+	switch n := node.(type) {
+	case bool:
+		if !n {
+			return resolvedSchema{}, errors.New("schema 'false' does not allow any value")
+		}
+
+		return resolvedSchema{base: base}, nil
+	case map[string]any:
+		sch := n
+		if ref, ok := sch["$ref"].(string); ok {
+			target, targetBase, err := g.follow(ref, base)
+			if err != nil {
+				return resolvedSchema{}, err
+			}
+
+			resolved, err := g.resolve(target, targetBase, depth+1)
+			if err != nil {
+				return resolvedSchema{}, err
+			}
+
+			sch = mergeSchemas(resolved.schema, withoutKeywords(sch, "$ref"))
+			base = resolved.base
+		}
+
+		if allOf, ok := sch["allOf"].([]any); ok {
+			merged := withoutKeywords(sch, "allOf")
+			for _, part := range allOf {
+				resolved, err := g.resolve(part, base, depth+1)
+				if err != nil {
+					return resolvedSchema{}, err
+				}
+
+				merged = mergeSchemas(merged, resolved.schema)
+			}
+
+			sch = merged
+		}
+
+		return resolvedSchema{schema: sch, base: base}, nil
+	default:
+		return resolvedSchema{}, fmt.Errorf("invalid schema node: %v", node)
+	}
+}
+
+// follow returns node pointed by ref and source of document containing it.
+func (g *generator) follow(ref, base string) (any, string, error) {
+	docRef, fragment, _ := strings.Cut(ref, "#")
+
+	source := base
+	if docRef != "" {
+		var err error
+		source, err = resolveSource(base, docRef)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	doc, err := g.document(source)
+	if err != nil {
+		return nil, "", err
+	}
+
+	node, err := pointer(doc, fragment)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not resolve $ref '%s', err: %w", ref, err)
+	}
+
+	return node, source, nil
+}
+
+// document returns schema document from source, loading it when necessary.
+func (g *generator) document(source string) (any, error) {
+	if doc, ok := g.documents[source]; ok {
+		return doc, nil
+	}
+
+	var raw []byte
+	var err error
+	if strings.HasPrefix(source, "file://") {
+		raw, err = os.ReadFile(strings.TrimPrefix(source, "file://"))
+	} else {
+		raw, err = fetch(source)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not load schema from %s, err: %w", source, err)
+	}
+
+	var doc any
+	if err = json.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("could not parse JSON schema from %s, err: %w", source, err)
+	}
+
+	g.documents[source] = doc
+
+	return doc, nil
+}
+
+// fetchClient is HTTP(s) client used to download schema documents.
+var fetchClient = &http.Client{Timeout: fetchTimeout}
+
+// fetch downloads document from URL.
+func fetch(source string) ([]byte, error) {
+	resp, err := fetchClient.Get(source)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// resolveSource resolves document reference against base source.
+func resolveSource(base, ref string) (string, error) {
+	refURL, err := url.Parse(ref)
+	if err == nil && refURL.Scheme != "" {
+		return ref, nil
+	}
+
+	if strings.HasPrefix(base, "file://") {
+		if path.IsAbs(ref) {
+			return "file://" + ref, nil
+		}
+
+		return "file://" + path.Join(path.Dir(strings.TrimPrefix(base, "file://")), ref), nil
+	}
+
+	baseURL, err := url.Parse(base)
+	if err != nil || baseURL.Scheme == "" {
+		return "", fmt.Errorf("can't resolve relative $ref '%s' without base location, use absolute URL or schema reference", ref)
+	}
+
+	if refURL == nil {
+		return "", fmt.Errorf("invalid $ref '%s'", ref)
+	}
+
+	return baseURL.ResolveReference(refURL).String(), nil
+}
+
+// pointer returns node from doc pointed by JSON pointer fragment.
+func pointer(doc any, fragment string) (any, error) {
+	fragment, err := url.PathUnescape(fragment)
+	if err != nil {
+		return nil, err
+	}
+
+	if fragment == "" || fragment == "/" {
+		return doc, nil
+	}
+
+	if !strings.HasPrefix(fragment, "/") {
+		return nil, fmt.Errorf("only JSON pointer fragments are supported, got: %s", fragment)
+	}
+
+	node := doc
+	for _, token := range strings.Split(fragment[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+
+		switch n := node.(type) {
+		case map[string]any:
+			next, ok := n[token]
+			if !ok {
+				return nil, fmt.Errorf("missing key '%s'", token)
+			}
+
+			node = next
+		case []any:
+			idx, err := strconv.Atoi(token)
+			if err != nil || idx < 0 || idx >= len(n) {
+				return nil, fmt.Errorf("invalid index '%s'", token)
+			}
+
+			node = n[idx]
+		default:
+			return nil, fmt.Errorf("can't follow '%s' in scalar value", token)
+		}
+	}
+
+	return node, nil
+}
+
+// pickType picks one of types allowed by schema.
+func (g *generator) pickType(sch map[string]any) string {
+	switch t := sch["type"].(type) {
+	case string:
+		return t
+	case []any:
+		candidates := make([]string, 0, len(t))
+		for _, c := range t {
+			if s, ok := c.(string); ok && (s != "null" || len(t) == 1) {
+				candidates = append(candidates, s)
+			}
+		}
+
+		if len(candidates) > 0 {
+			if g.mode == GenerationModeRandom {
+				return candidates[mathutils.MustRandomInt(0, len(candidates)-1)]
+			}
+
+			return candidates[0]
+		}
+	}
+
+	if hasAnyKeyword(sch, "properties", "required", "additionalProperties", "minProperties", "maxProperties") {
+		return "object"
+	}
+
+	if hasAnyKeyword(sch, "items", "prefixItems", "minItems", "maxItems", "uniqueItems", "contains") {
+		return "array"
+	}
+
+	if hasAnyKeyword(sch, "minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum", "multipleOf") {
+		return "number"
+	}
+
+	return "string"
+}
+
+// generateObject generates object valid against schema.
+func (g *generator) generateObject(sch resolvedSchema, depth int) (any, error) {
+	properties, _ := sch.schema["properties"].(map[string]any)
+	required := toStrings(sch.schema["required"])
+	isRequired := make(map[string]bool, len(required))
+	for _, name := range required {
+		isRequired[name] = true
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	include := func(name string) bool {
+		if isRequired[name] {
+			return true
+		}
+
+		if depth > softDepthLimit {
+			return false
+		}
+
+		switch g.mode {
+		case GenerationModeMinimal:
+			return false
+		case GenerationModeMaximal:
+			return true
+		default:
+			return mathutils.MustRandomInt(0, 1) == 1
+		}
+	}
+
+	maxProperties, hasMaxProperties := toInt(sch.schema["maxProperties"])
+	result := make(map[string]any, len(names))
+	for _, name := range names {
+		if !include(name) || (hasMaxProperties && !isRequired[name] && len(result) >= maxProperties-countMissing(result, required)) {
+			continue
+		}
+
+		value, err := g.generate(properties[name], sch.base, depth+1)
+		if err != nil {
+			return nil, fmt.Errorf("property '%s': %w", name, err)
+		}
+
+		result[name] = value
+	}
+
+	additional := sch.schema["additionalProperties"]
+	for _, name := range required {
+		if _, ok := result[name]; ok {
+			continue
+		}
+
+		value, err := g.generateAdditional(additional, sch.base, depth)
+		if err != nil {
+			return nil, fmt.Errorf("property '%s': %w", name, err)
+		}
+
+		result[name] = value
+	}
+
+	minProperties, _ := toInt(sch.schema["minProperties"])
+	for _, name := range names {
+		if len(result) >= minProperties {
+			break
+		}
+
+		if _, ok := result[name]; ok {
+			continue
+		}
+
+		value, err := g.generate(properties[name], sch.base, depth+1)
+		if err != nil {
+			return nil, fmt.Errorf("property '%s': %w", name, err)
+		}
+
+		result[name] = value
+	}
+
+	for i := 0; len(result) < minProperties; i++ {
+		if isFalse(additional) {
+			return nil, fmt.Errorf("schema requires at least %d properties, but only %d are allowed", minProperties, len(result))
+		}
+
+		name := fmt.Sprintf("property%d", i)
+		if _, ok := result[name]; ok {
+			continue
+		}
+
+		value, err := g.generateAdditional(additional, sch.base, depth)
+		if err != nil {
+			return nil, fmt.Errorf("property '%s': %w", name, err)
+		}
+
+		result[name] = value
+	}
+
+	return result, nil
+}
+
+// generateAdditional generates value of property not listed in schema properties.
+func (g *generator) generateAdditional(additional any, base string, depth int) (any, error) {
+	if additional == nil || additional == true {
+		return randomString(1, defaultStringSpread), nil
+	}
+
+	return g.generate(additional, base, depth+1)
+}
+
+// generateArray generates array valid against schema.
+func (g *generator) generateArray(sch resolvedSchema, depth int) (any, error) {
+	minItems, _ := toInt(sch.schema["minItems"])
+	maxItems, hasMaxItems := toInt(sch.schema["maxItems"])
+	if !hasMaxItems {
+		maxItems = minItems + defaultArraySpread
+	}
+
+	if minItems > maxItems {
+		return nil, fmt.Errorf("minItems %d is greater than maxItems %d", minItems, maxItems)
+	}
+
+	tuple, _ := sch.schema["prefixItems"].([]any)
+	rest := sch.schema["items"]
+	if tuple == nil {
+		if items, ok := sch.schema["items"].([]any); ok {
+			tuple = items
+			rest = sch.schema["additionalItems"]
+		}
+	}
+
+	if isFalse(rest) {
+		if minItems > len(tuple) {
+			return nil, fmt.Errorf("schema requires at least %d items, but only %d are allowed", minItems, len(tuple))
+		}
+
+		if maxItems > len(tuple) {
+			maxItems = len(tuple)
+		}
+	}
+
+	count := pickInRange(minItems, maxItems, g.mode)
+	if depth > softDepthLimit {
+		count = minItems
+	}
+
+	contains, hasContains := sch.schema["contains"]
+	if hasContains && count == 0 {
+		count = 1
+	}
+
+	unique, _ := sch.schema["uniqueItems"].(bool)
+	result := make([]any, 0, count)
+	for i := 0; i < count; i++ {
+		itemSchema := rest
+		if i < len(tuple) {
+			itemSchema = tuple[i]
+		} else if hasContains && i == 0 {
+			itemSchema = contains
+		}
+
+		var item any
+		var err error
+		for attempt := 0; attempt < maxAttempts; attempt++ {
+			if itemSchema == nil || itemSchema == true {
+				item = randomString(1, defaultStringSpread)
+			} else {
+				item, err = g.generate(itemSchema, sch.base, depth+1)
+				if err != nil {
+					return nil, fmt.Errorf("item %d: %w", i, err)
+				}
+			}
+
+			if !unique || !containsValue(result, item) {
+				break
+			}
+		}
+
+		if unique && containsValue(result, item) {
+			if i >= minItems {
+				break
+			}
+
+			return nil, fmt.Errorf("could not generate %d unique items", minItems)
+		}
+
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+// generateString generates string valid against schema.
+func (g *generator) generateString(sch map[string]any) (any, error) {
+	minLength, _ := toInt(sch["minLength"])
+	maxLength, hasMaxLength := toInt(sch["maxLength"])
+	if !hasMaxLength {
+		maxLength = minLength + defaultStringSpread
+	}
+
+	if minLength > maxLength {
+		return nil, fmt.Errorf("minLength %d is greater than maxLength %d", minLength, maxLength)
+	}
+
+	if format, ok := sch["format"].(string); ok {
+		formatMaxLength := maxLength
+		if !hasMaxLength {
+			formatMaxLength = math.MaxInt32
+		}
+
+		if value, ok := formatValue(format, minLength, formatMaxLength); ok {
+			if length := len([]rune(value)); length < minLength || length > formatMaxLength {
+				return nil, fmt.Errorf("could not generate string of format '%s' with length between %d and %d", format, minLength, maxLength)
+			}
+
+			return value, nil
+		}
+	}
+
+	pattern, ok := sch["pattern"].(string)
+	if !ok {
+		length := pickInRange(minLength, maxLength, g.mode)
+
+		return randomString(length, length), nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern '%s', err: %w", pattern, err)
+	}
+
+	mode := g.mode
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		value, err := generateFromPattern(pattern, mode)
+		if err != nil {
+			return nil, err
+		}
+
+		length := len([]rune(value))
+		if re.MatchString(value) && length >= minLength && length <= maxLength {
+			return value, nil
+		}
+
+		mode = GenerationModeRandom
+	}
+
+	return nil, fmt.Errorf("could not generate string matching pattern '%s' with length between %d and %d", pattern, minLength, maxLength)
+}
+
+// generateInteger generates integer valid against schema.
+func (g *generator) generateInteger(sch map[string]any) (any, error) {
+	lo, hi := numberRange(sch)
+	from, to := lo.value, hi.value
+	if lo.exclusive {
+		from = math.Floor(from) + 1
+	} else {
+		from = math.Ceil(from)
+	}
+
+	if hi.exclusive {
+		to = math.Ceil(to) - 1
+	} else {
+		to = math.Floor(to)
+	}
+
+	step := 1.0
+	if multipleOf, ok := toFloat(sch["multipleOf"]); ok && multipleOf > 0 {
+		var err error
+		if step, err = integerStep(multipleOf); err != nil {
+			return nil, err
+		}
+	}
+
+	kFrom, kTo := math.Ceil(from/step), math.Floor(to/step)
+	if kFrom > kTo {
+		return nil, fmt.Errorf("there is no integer between %v and %v", lo.value, hi.value)
+	}
+
+	k := kFrom + float64(pickInRange(0, int(math.Min(kTo-kFrom, math.MaxInt32)), g.mode))
+	value := k * step
+	if value != math.Trunc(value) {
+		return nil, fmt.Errorf("there is no integer being multiple of %v between %v and %v", step, lo.value, hi.value)
+	}
+
+	return int64(value), nil
+}
+
+// generateNumber generates number valid against schema.
+func (g *generator) generateNumber(sch map[string]any) (any, error) {
+	lo, hi := numberRange(sch)
+	if lo.value > hi.value || (lo.value == hi.value && (lo.exclusive || hi.exclusive)) {
+		return nil, fmt.Errorf("there is no number between %v and %v", lo.value, hi.value)
+	}
+
+	if multipleOf, ok := toFloat(sch["multipleOf"]); ok && multipleOf > 0 {
+		kFrom, kTo := math.Ceil(lo.value/multipleOf), math.Floor(hi.value/multipleOf)
+		if lo.exclusive && kFrom*multipleOf <= lo.value {
+			kFrom++
+		}
+
+		if hi.exclusive && kTo*multipleOf >= hi.value {
+			kTo--
+		}
+
+		if kFrom > kTo {
+			return nil, fmt.Errorf("there is no number being multiple of %v between %v and %v", multipleOf, lo.value, hi.value)
+		}
+
+		k := kFrom + float64(pickInRange(0, int(math.Min(kTo-kFrom, math.MaxInt32)), g.mode))
+
+		return roundToPrecisionOf(k*multipleOf, multipleOf), nil
+	}
+
+	margin := math.Min(0.01, (hi.value-lo.value)/2)
+	from, to := lo.value, hi.value
+	if lo.exclusive {
+		from += margin
+	}
+
+	if hi.exclusive {
+		to -= margin
+	}
+
+	switch g.mode {
+	case GenerationModeMinimal:
+		return from, nil
+	case GenerationModeMaximal:
+		return to, nil
+	}
+
+	value := mathutils.MustRandomFloat64(from, to)
+	if rounded := math.Round(value*100) / 100; rounded >= from && rounded <= to {
+		return rounded, nil
+	}
+
+	return value, nil
+}
+
+// bound represents one side of numeric range.
+type bound struct {
+	value     float64
+	exclusive bool
+}
+
+// numberRange returns range of numbers allowed by schema, supports draft 4 and later exclusive bounds notation.
+func numberRange(sch map[string]any) (bound, bound) {
+	lo, hasLo := boundOf(sch, "minimum", "exclusiveMinimum", math.Max)
+	hi, hasHi := boundOf(sch, "maximum", "exclusiveMaximum", math.Min)
+
+	if !hasLo {
+		lo.value = 0
+		if hasHi && hi.value < 0 {
+			lo.value = hi.value - defaultNumberSpread
+		}
+	}
+
+	if !hasHi {
+		hi.value = lo.value + defaultNumberSpread
+	}
+
+	return lo, hi
+}
+
+// boundOf returns the strictest bound defined with inclusive and exclusive keywords.
+func boundOf(sch map[string]any, inclusiveKeyword, exclusiveKeyword string, stricter func(a, b float64) float64) (bound, bool) {
+	inclusive, hasInclusive := toFloat(sch[inclusiveKeyword])
+
+	if isExclusive, ok := sch[exclusiveKeyword].(bool); ok {
+		return bound{value: inclusive, exclusive: isExclusive && hasInclusive}, hasInclusive
+	}
+
+	exclusive, hasExclusive := toFloat(sch[exclusiveKeyword])
+	switch {
+	case hasInclusive && hasExclusive:
+		value := stricter(inclusive, exclusive)
+
+		return bound{value: value, exclusive: value == exclusive}, true
+	case hasExclusive:
+		return bound{value: exclusive, exclusive: true}, true
+	default:
+		return bound{value: inclusive}, hasInclusive
+	}
+}
+
+// integerStep returns the smallest positive integer being multiple of multipleOf.
+func integerStep(multipleOf float64) (float64, error) {
+	decimals := 0
+	if _, frac, found := strings.Cut(strconv.FormatFloat(multipleOf, 'f', -1, 64), "."); found {
+		decimals = len(frac)
+	}
+
+	if decimals > 9 || multipleOf*math.Pow10(decimals) > math.MaxInt32 {
+		return 0, fmt.Errorf("multipleOf %v is not supported for integers", multipleOf)
+	}
+
+	scale := int64(math.Pow10(decimals))
+	scaled := int64(math.Round(multipleOf * float64(scale)))
+	if scaled == 0 {
+		return 0, fmt.Errorf("multipleOf %v is not supported for integers", multipleOf)
+	}
+
+	a, b := scaled, scale
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return float64(scaled / a), nil
+}
+
+// formatValue returns value valid against given JSON schema format, with length between minLength and maxLength
+// when format allows it. Second argument tells whether format is known.
+func formatValue(format string, minLength, maxLength int) (string, bool) {
+	now := time.Now().UTC()
+	wordOf := func(overhead int) string {
+		from, to := minLength-overhead, maxLength-overhead
+		if from < 4 {
+			from = 4
+		}
+
+		if to > from+6 {
+			to = from + 6
+		}
+
+		if from > to {
+			from = to
+		}
+
+		if from < 1 {
+			from, to = 1, 1
+		}
+
+		return strings.ToLower(randomString(from, to))
+	}
+
+	switch format {
+	case "date-time":
+		return now.Format(time.RFC3339), true
+	case "date":
+		return now.Format("2006-01-02"), true
+	case "time":
+		return now.Format("15:04:05Z07:00"), true
+	case "email", "idn-email":
+		return fmt.Sprintf("%s@example.com", wordOf(len("@example.com"))), true
+	case "hostname", "idn-hostname":
+		return fmt.Sprintf("%s.example.com", wordOf(len(".example.com"))), true
+	case "ipv4":
+		return fmt.Sprintf("%d.%d.%d.%d", mathutils.MustRandomInt(1, 223), mathutils.MustRandomInt(0, 255),
+			mathutils.MustRandomInt(0, 255), mathutils.MustRandomInt(1, 254)), true
+	case "ipv6":
+		return fmt.Sprintf("2001:db8::%x:%x", mathutils.MustRandomInt(1, 0xffff), mathutils.MustRandomInt(1, 0xffff)), true
+	case "uri", "url", "iri", "uri-reference", "iri-reference":
+		return fmt.Sprintf("https://example.com/%s", wordOf(len("https://example.com/"))), true
+	case "uuid":
+		return randomUUID(), true
+	case "json-pointer":
+		return "/" + wordOf(len("/")), true
+	case "regex":
+		return "^" + wordOf(len("^$")) + "$", true
+	default:
+		return "", false
+	}
+}
+
+// randomUUID returns random UUID in version 4.
+func randomUUID() string {
+	b := make([]byte, 16)
+	for i := range b {
+		b[i] = byte(mathutils.MustRandomInt(0, 255))
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// randomString returns alphanumeric string with length from given range.
+func randomString(from, to int) string {
+	length := mathutils.MustRandomInt(from, to)
+	b := make([]byte, length)
+	for i := range b {
+		b[i] = alphanumeric[mathutils.MustRandomInt(0, len(alphanumeric)-1)]
+	}
+
+	return string(b)
+}
+
+// roundToPrecisionOf rounds value to number of decimal places of precision.
+func roundToPrecisionOf(value, precision float64) float64 {
+	decimals := 0
+	if _, frac, found := strings.Cut(strconv.FormatFloat(precision, 'f', -1, 64), "."); found {
+		decimals = len(frac)
+	}
+
+	rounded, err := strconv.ParseFloat(strconv.FormatFloat(value, 'f', decimals, 64), 64)
+	if err != nil {
+		return value
+	}
+
+	return rounded
+}
+
+// mergeSchemas returns new schema holding keywords of both schemas. Properties and required keywords are joined.
+func mergeSchemas(a, b map[string]any) map[string]any {
+	merged := make(map[string]any, len(a)+len(b))
+	for k, val := range a {
+		merged[k] = val
+	}
+
+	for k, val := range b {
+		existing, exists := merged[k]
+		if !exists {
+			merged[k] = val
+			continue
+		}
+
+		switch k {
+		case "properties":
+			existingProps, okA := existing.(map[string]any)
+			newProps, okB := val.(map[string]any)
+			if okA && okB {
+				props := make(map[string]any, len(existingProps)+len(newProps))
+				for name, p := range existingProps {
+					props[name] = p
+				}
+
+				for name, p := range newProps {
+					props[name] = p
+				}
+
+				merged[k] = props
+				continue
+			}
+		case "required":
+			joined := append(toAny(toStrings(existing)), toAny(toStrings(val))...)
+			merged[k] = joined
+			continue
+		case "minimum", "exclusiveMinimum", "minLength", "minItems", "minProperties":
+			if x, okA := toFloat(existing); okA {
+				if y, okB := toFloat(val); okB {
+					merged[k] = math.Max(x, y)
+					continue
+				}
+			}
+		case "maximum", "exclusiveMaximum", "maxLength", "maxItems", "maxProperties":
+			if x, okA := toFloat(existing); okA {
+				if y, okB := toFloat(val); okB {
+					merged[k] = math.Min(x, y)
+					continue
+				}
+			}
+		}
+
+		merged[k] = val
+	}
+
+	return merged
+}
+
+// withoutKeywords returns copy of schema without given keywords.
+func withoutKeywords(sch map[string]any, keywords ...string) map[string]any {
+	result := make(map[string]any, len(sch))
+	for k, val := range sch {
+		result[k] = val
+	}
+
+	for _, keyword := range keywords {
+		delete(result, keyword)
+	}
+
+	return result
+}
+
+func hasAnyKeyword(sch map[string]any, keywords ...string) bool {
+	for _, keyword := range keywords {
+		if _, ok := sch[keyword]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func countMissing(result map[string]any, names []string) int {
+	missing := 0
+	for _, name := range names {
+		if _, ok := result[name]; !ok {
+			missing++
+		}
+	}
+
+	return missing
+}
+
+func containsValue(values []any, value any) bool {
+	for _, val := range values {
+		if reflect.DeepEqual(val, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isFalse(val any) bool {
+	b, ok := val.(bool)
+
+	return ok && !b
+}
+
+func toStrings(val any) []string {
+	items, ok := val.([]any)
+	if !ok {
+		return nil
+	}
+
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			result = append(result, s)
+		}
+	}
+
+	return result
+}
+
+func toAny(values []string) []any {
+	result := make([]any, 0, len(values))
+	for _, val := range values {
+		result = append(result, val)
+	}
+
+	return result
+}
+
+func toFloat(val any) (float64, bool) {
+	switch n := val.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func toInt(val any) (int, bool) {
+	f, ok := toFloat(val)
+
+	return int(f), ok
+}
@@ -0,0 +1,267 @@
+package schema
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var userSchema = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "required": ["id", "name", "email", "role", "tags", "address"],
+  "additionalProperties": false,
+  "definitions": {
+    "address": {
+      "type": "object",
+      "required": ["city", "zip"],
+      "properties": {
+        "city": {"type": "string", "minLength": 3, "maxLength": 20},
+        "zip": {"type": "string", "pattern": "^[0-9]{2}-[0-9]{3}$"}
+      }
+    }
+  },
+  "properties": {
+    "id": {"type": "string", "format": "uuid"},
+    "name": {"type": "string", "minLength": 2, "maxLength": 10},
+    "email": {"type": "string", "format": "email"},
+    "createdAt": {"type": "string", "format": "date-time"},
+    "age": {"type": "integer", "minimum": 18, "exclusiveMaximum": 100},
+    "score": {"type": "number", "exclusiveMinimum": 0, "maximum": 5, "multipleOf": 0.5},
+    "role": {"enum": ["admin", "user", "guest"]},
+    "active": {"type": "boolean"},
+    "nickname": {"type": ["string", "null"]},
+    "tags": {"type": "array", "minItems": 1, "maxItems": 4, "uniqueItems": true, "items": {"type": "string", "pattern": "^[a-z]{3,8}$"}},
+    "address": {"$ref": "#/definitions/address"},
+    "contact": {"oneOf": [{"type": "string", "format": "ipv4"}, {"type": "integer", "multipleOf": 7}]},
+    "extra": {"allOf": [{"type": "object", "properties": {"a": {"type": "integer"}}, "required": ["a"]}, {"properties": {"b": {"const": "b"}}, "required": ["b"]}]}
+  }
+}`
+
+func TestJSONSchemaRawGenerator_Generate(t *testing.T) {
+	type args struct {
+		schema string
+		mode   GenerationMode
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{name: "invalid JSON schema", args: args{schema: `{"type": `, mode: GenerationModeRandom}, wantErr: true},
+		{name: "unknown generation mode", args: args{schema: `{"type": "string"}`, mode: "medium"}, wantErr: true},
+		{name: "unresolvable $ref", args: args{schema: `{"$ref": "#/definitions/missing"}`, mode: GenerationModeRandom}, wantErr: true},
+		{name: "relative external $ref without base location", args: args{schema: `{"$ref": "other.json"}`, mode: GenerationModeRandom}, wantErr: true},
+		{name: "impossible integer range", args: args{schema: `{"type": "integer", "minimum": 1.2, "maximum": 1.8}`, mode: GenerationModeRandom}, wantErr: true},
+		{name: "false schema", args: args{schema: `false`, mode: GenerationModeRandom}, wantErr: true},
+		{name: "infinitely recursive schema", args: args{schema: `{"$ref": "#"}`, mode: GenerationModeRandom}, wantErr: true},
+		{name: "complex schema - random", args: args{schema: userSchema, mode: GenerationModeRandom}, wantErr: false},
+		{name: "complex schema - minimal", args: args{schema: userSchema, mode: GenerationModeMinimal}, wantErr: false},
+		{name: "complex schema - maximal", args: args{schema: userSchema, mode: GenerationModeMaximal}, wantErr: false},
+		{name: "complex schema - default mode", args: args{schema: userSchema, mode: ""}, wantErr: false},
+		{name: "recursive schema", args: args{schema: `{"type": "object", "required": ["name"], "properties": {"name": {"type": "string"}, "children": {"type": "array", "items": {"$ref": "#"}}}}`, mode: GenerationModeMaximal}, wantErr: false},
+		{name: "draft 4 exclusive bounds", args: args{schema: `{"type": "number", "minimum": 1, "exclusiveMinimum": true, "maximum": 2, "exclusiveMaximum": true}`, mode: GenerationModeMinimal}, wantErr: false},
+		{name: "tuple items", args: args{schema: `{"type": "array", "items": [{"type": "integer"}, {"type": "boolean"}], "additionalItems": false, "minItems": 2}`, mode: GenerationModeMaximal}, wantErr: false},
+		{name: "format with length bounds", args: args{schema: `{"type": "string", "format": "email", "minLength": 30, "maxLength": 32}`, mode: GenerationModeRandom}, wantErr: false},
+		{name: "format with short max length", args: args{schema: `{"type": "string", "format": "uri", "maxLength": 22}`, mode: GenerationModeMaximal}, wantErr: false},
+		{name: "format longer than max length", args: args{schema: `{"type": "string", "format": "date-time", "maxLength": 10}`, mode: GenerationModeRandom}, wantErr: true},
+		{name: "integer with fractional multipleOf", args: args{schema: `{"type": "integer", "minimum": 1, "maximum": 20, "multipleOf": 2.5}`, mode: GenerationModeRandom}, wantErr: false},
+		{name: "integer with tiny multipleOf", args: args{schema: `{"type": "integer", "multipleOf": 0.1}`, mode: GenerationModeMaximal}, wantErr: false},
+		{name: "integer with unsupported multipleOf", args: args{schema: `{"type": "integer", "multipleOf": 1e-12}`, mode: GenerationModeRandom}, wantErr: true},
+		{name: "min properties", args: args{schema: `{"type": "object", "minProperties": 3, "additionalProperties": {"type": "integer"}}`, mode: GenerationModeMinimal}, wantErr: false},
+	}
+
+	validator := NewJSONSchemaRawXGValidator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				got, err := NewJSONSchemaRawGenerator().Generate(tt.args.schema, tt.args.mode)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("Generate() error = %v, wantErr %v", err, tt.wantErr)
+				}
+
+				if tt.wantErr {
+					return
+				}
+
+				document, err := json.Marshal(got)
+				if err != nil {
+					t.Fatalf("could not serialize generated data, err: %v", err)
+				}
+
+				if err = validator.Validate(string(document), tt.args.schema); err != nil {
+					t.Fatalf("generated document %s is not valid against schema, err: %v", document, err)
+				}
+			}
+		})
+	}
+}
+
+func TestJSONSchemaRawGenerator_Generate_modes(t *testing.T) {
+	schema := `{"type": "object", "required": ["a"], "properties": {"a": {"type": "integer", "minimum": 5, "maximum": 10}, "b": {"type": "string", "minLength": 1, "maxLength": 3}}}`
+
+	minimal, err := NewJSONSchemaRawGenerator().Generate(schema, GenerationModeMinimal)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m := minimal.(map[string]any); len(m) != 1 || m["a"] != int64(5) {
+		t.Errorf("minimal instance should hold only required property with the lowest value, got: %v", m)
+	}
+
+	maximal, err := NewJSONSchemaRawGenerator().Generate(schema, GenerationModeMaximal)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m := maximal.(map[string]any); len(m) != 2 || m["a"] != int64(10) || len(m["b"].(string)) != 3 {
+		t.Errorf("maximal instance should hold all properties with the highest values, got: %v", m)
+	}
+}
+
+func TestJSONSchemaReferenceGenerator_Generate(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"user.json":           `{"type": "object", "required": ["address"], "properties": {"address": {"$ref": "common/address.json#/definitions/address"}}}`,
+		"common/address.json": `{"definitions": {"address": {"type": "object", "required": ["city"], "properties": {"city": {"$ref": "#/definitions/city"}}}, "city": {"type": "string", "minLength": 3}}}`,
+	}
+
+	for name, content := range files {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	gen := NewDefaultJSONSchemaReferenceGenerator(dir)
+
+	got, err := gen.Generate("user.json", GenerationModeRandom)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	document, _ := json.Marshal(got)
+	if err = NewDefaultJSONSchemaReferenceXGValidator(dir).Validate(string(document), "user.json"); err != nil {
+		t.Errorf("generated document %s is not valid against schema, err: %v", document, err)
+	}
+
+	if _, err = gen.Generate("missing.json", GenerationModeRandom); err == nil {
+		t.Errorf("Generate() expected error for missing schema")
+	}
+}
+
+func Test_fetch(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/slow.json":
+			<-release
+		case "/missing.json":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			_, _ = w.Write([]byte(`{"type": "integer"}`))
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	defaultClient := fetchClient
+	fetchClient = &http.Client{Timeout: 100 * time.Millisecond}
+	defer func() { fetchClient = defaultClient }()
+
+	if got, err := fetch(server.URL + "/schema.json"); err != nil || string(got) != `{"type": "integer"}` {
+		t.Errorf("fetch() got = %s, err = %v", got, err)
+	}
+
+	if _, err := fetch(server.URL + "/missing.json"); err == nil {
+		t.Errorf("fetch() expected error for unexpected status code")
+	}
+
+	start := time.Now()
+	if _, err := fetch(server.URL + "/slow.json"); err == nil || time.Since(start) > 5*time.Second {
+		t.Errorf("fetch() expected timeout error, err = %v", err)
+	}
+}
+
+func Test_generateFromPattern(t *testing.T) {
+	patterns := []string{`^[a-z]{3,8}$`, `^\d{2}-\d{3}$`, `^(foo|bar)+baz?$`, `[A-Z][a-z]*@x\.com`, `^[^0-9]+$`, `^.{5}$`}
+
+	for _, pattern := range patterns {
+		for _, mode := range []GenerationMode{GenerationModeRandom, GenerationModeMinimal, GenerationModeMaximal} {
+			got, err := generateFromPattern(pattern, mode)
+			if err != nil {
+				t.Fatalf("generateFromPattern(%s) error = %v", pattern, err)
+			}
+
+			if !regexp.MustCompile(pattern).MatchString(got) {
+				t.Errorf("generateFromPattern(%s) = %s, does not match pattern", pattern, got)
+			}
+		}
+	}
+}
+
+func TestJSONSchemaRawGenerator_GenerateInvalid(t *testing.T) {
+	document := map[string]any{
+		"id":      "7b1d5d57-8d1d-4c35-9d2c-6c8a3c5e1f10",
+		"name":    "john",
+		"email":   "john@example.com",
+		"age":     30,
+		"score":   2.5,
+		"role":    "admin",
+		"tags":    []any{"abc"},
+		"address": map[string]any{"city": "Warsaw", "zip": "00-950"},
+	}
+
+	validator := NewJSONSchemaRawXGValidator()
+	valid, _ := json.Marshal(document)
+	if err := validator.Validate(string(valid), userSchema); err != nil {
+		t.Fatalf("base document should be valid, err: %v", err)
+	}
+
+	mutations, err := NewJSONSchemaRawGenerator().GenerateInvalid(userSchema, document)
+	if err != nil {
+		t.Fatalf("GenerateInvalid() error = %v", err)
+	}
+
+	wantDescriptions := []string{
+		"$.address.city: removed required property",
+		"$: added property 'unexpectedProperty' not allowed by additionalProperties",
+		"$.address.zip: string does not match pattern '^[0-9]{2}-[0-9]{3}$'",
+		"$.age: number 17 is below allowed minimum",
+		"$.age: number 100 is above allowed maximum",
+		"$.tags: array has 0 items, but minItems is 1",
+		"$.role: value is not one of enum values",
+	}
+
+	descriptions := map[string]bool{}
+	for _, mutation := range mutations {
+		descriptions[mutation.Description] = true
+
+		variant, err := json.Marshal(mutation.Document)
+		if err != nil {
+			t.Fatalf("could not serialize mutation %s, err: %v", mutation.Description, err)
+		}
+
+		if err = validator.Validate(string(variant), userSchema); err == nil {
+			t.Errorf("mutation '%s' produced document %s valid against schema", mutation.Description, variant)
+		}
+	}
+
+	for _, description := range wantDescriptions {
+		if !descriptions[description] {
+			t.Errorf("expected mutation '%s' not generated", description)
+		}
+	}
+
+	if _, err = NewJSONSchemaRawGenerator().GenerateInvalid(`{"type": `, document); err == nil {
+		t.Errorf("GenerateInvalid() expected error for invalid schema")
+	}
+}
@@ -0,0 +1,408 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Mutation describes document modified in a way that violates JSON schema.
+type Mutation struct {
+	// Description tells what was changed in document and which schema keyword is violated.
+	Description string
+
+	// Document is modified copy of document.
+	Document any
+}
+
+// GenerateInvalid returns variants of document, each of them violating JSON schema in different way.
+// Document should be valid against jsonSchema.
+func (g JSONSchemaRawGenerator) GenerateInvalid(jsonSchema string, document any) ([]Mutation, error) {
+	var doc any
+	if err := json.Unmarshal([]byte(jsonSchema), &doc); err != nil {
+		return nil, fmt.Errorf("could not parse JSON schema, err: %w", err)
+	}
+
+	gen, err := newGenerator(GenerationModeRandom)
+	if err != nil {
+		return nil, err
+	}
+
+	gen.documents[""] = doc
+
+	return gen.mutate(doc, "", normalizeDocument(document))
+}
+
+// GenerateInvalid returns variants of document, each of them violating JSON schema located in schemaPath in different way.
+// Document should be valid against schema. schemaPath may be URL or relative/full path to json schema on user OS.
+func (g JSONSchemaReferenceGenerator) GenerateInvalid(schemaPath string, document any) ([]Mutation, error) {
+	source, err := getSource(g.urlValidator, g.fileValidator, g.schemasDir, schemaPath)
+	if err != nil {
+		return nil, err
+	}
+
+	gen, err := newGenerator(GenerationModeRandom)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := gen.document(source)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.mutate(doc, source, normalizeDocument(document))
+}
+
+// mutate collects mutations of document against schema node.
+func (g *generator) mutate(node any, base string, document any) ([]Mutation, error) {
+	m := &mutator{generator: g, root: document}
+	if err := m.walk(node, base, document, nil, 0); err != nil {
+		return nil, err
+	}
+
+	return m.mutations, nil
+}
+
+// mutator walks through schema and document simultaneously and collects mutations.
+type mutator struct {
+	generator *generator
+	root      any
+	mutations []Mutation
+}
+
+// walk collects mutations of value located under path in document.
+func (m *mutator) walk(node any, base string, value any, path []any, depth int) error {
+	sch, err := m.generator.resolve(node, base, depth)
+	if err != nil {
+		return err
+	}
+
+	if sch.schema == nil {
+		return nil
+	}
+
+	m.mutateType(sch.schema, value, path)
+
+	if c, ok := sch.schema["const"]; ok {
+		m.replace(path, fmt.Sprintf("%v-changed", c), "value differs from const")
+	}
+
+	if enum, ok := sch.schema["enum"].([]any); ok {
+		m.replace(path, outsideOfEnum(enum), "value is not one of enum values")
+	}
+
+	switch val := value.(type) {
+	case map[string]any:
+		return m.mutateObject(sch, val, path, depth)
+	case []any:
+		return m.mutateArray(sch, val, path, depth)
+	case string:
+		m.mutateString(sch.schema, val, path)
+	case float64:
+		m.mutateNumber(sch.schema, path)
+	}
+
+	return nil
+}
+
+// mutateType replaces value with value of type not allowed by schema.
+func (m *mutator) mutateType(sch map[string]any, value any, path []any) {
+	allowed := map[string]bool{}
+	switch t := sch["type"].(type) {
+	case string:
+		allowed[t] = true
+	case []any:
+		for _, typ := range toStrings(t) {
+			allowed[typ] = true
+		}
+	default:
+		return
+	}
+
+	if allowed["number"] {
+		allowed["integer"] = true
+	}
+
+	candidates := []struct {
+		typ   string
+		value any
+	}{
+		{typ: "string", value: "invalid"},
+		{typ: "integer", value: float64(12345)},
+		{typ: "boolean", value: true},
+		{typ: "object", value: map[string]any{}},
+		{typ: "null", value: nil},
+	}
+
+	for _, c := range candidates {
+		if !allowed[c.typ] {
+			m.replace(path, c.value, fmt.Sprintf("value has type %s, but schema allows only: %s", c.typ, strings.Join(sortedKeys(allowed), ", ")))
+
+			return
+		}
+	}
+}
+
+// mutateObject collects mutations of object and its properties.
+func (m *mutator) mutateObject(sch resolvedSchema, value map[string]any, path []any, depth int) error {
+	for _, name := range toStrings(sch.schema["required"]) {
+		if _, ok := value[name]; ok {
+			propPath := append(copyPath(path), name)
+			m.add(removeAt(m.root, propPath), fmt.Sprintf("%s: removed required property", formatPath(propPath)))
+		}
+	}
+
+	if isFalse(sch.schema["additionalProperties"]) {
+		extended := copyMap(value)
+		extended["unexpectedProperty"] = "unexpected"
+		m.add(setAt(m.root, path, extended), fmt.Sprintf("%s: added property 'unexpectedProperty' not allowed by additionalProperties", formatPath(path)))
+	}
+
+	properties, _ := sch.schema["properties"].(map[string]any)
+	for _, name := range sortedKeys(value) {
+		propSchema, ok := properties[name]
+		if !ok {
+			continue
+		}
+
+		if err := m.walk(propSchema, sch.base, value[name], append(copyPath(path), name), depth+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mutateArray collects mutations of array and its first item.
+func (m *mutator) mutateArray(sch resolvedSchema, value []any, path []any, depth int) error {
+	if minItems, ok := toInt(sch.schema["minItems"]); ok && minItems > 0 && len(value) >= minItems {
+		m.replace(path, append([]any{}, value[:minItems-1]...), fmt.Sprintf("array has %d items, but minItems is %d", minItems-1, minItems))
+	}
+
+	if maxItems, ok := toInt(sch.schema["maxItems"]); ok && len(value) > 0 {
+		extended := append([]any{}, value...)
+		for len(extended) <= maxItems {
+			extended = append(extended, value[len(value)-1])
+		}
+
+		m.replace(path, extended, fmt.Sprintf("array has %d items, but maxItems is %d", len(extended), maxItems))
+	}
+
+	if unique, _ := sch.schema["uniqueItems"].(bool); unique && len(value) > 0 {
+		m.replace(path, append(append([]any{}, value...), value[0]), "array has duplicated items, but uniqueItems is true")
+	}
+
+	if len(value) == 0 {
+		return nil
+	}
+
+	itemSchema := sch.schema["items"]
+	if tuple, ok := sch.schema["prefixItems"].([]any); ok && len(tuple) > 0 {
+		itemSchema = tuple[0]
+	} else if tuple, ok := itemSchema.([]any); ok && len(tuple) > 0 {
+		itemSchema = tuple[0]
+	}
+
+	if itemSchema == nil {
+		return nil
+	}
+
+	return m.walk(itemSchema, sch.base, value[0], append(copyPath(path), 0), depth+1)
+}
+
+// mutateString collects mutations of string violating length, pattern and format keywords.
+func (m *mutator) mutateString(sch map[string]any, value string, path []any) {
+	if minLength, ok := toInt(sch["minLength"]); ok && minLength > 0 {
+		m.replace(path, strings.Repeat("a", minLength-1), fmt.Sprintf("string is shorter than minLength %d", minLength))
+	}
+
+	if maxLength, ok := toInt(sch["maxLength"]); ok {
+		m.replace(path, strings.Repeat("a", maxLength+1), fmt.Sprintf("string is longer than maxLength %d", maxLength))
+	}
+
+	if pattern, ok := sch["pattern"].(string); ok {
+		if re, err := regexp.Compile(pattern); err == nil {
+			for _, candidate := range []string{"", "!", "#invalid#", " ", "0", "a", value + "!", "!" + value} {
+				if !re.MatchString(candidate) {
+					m.replace(path, candidate, fmt.Sprintf("string does not match pattern '%s'", pattern))
+					break
+				}
+			}
+		}
+	}
+
+	if format, ok := sch["format"].(string); ok {
+		if _, isKnown := formatValue(format, 0, defaultStringSpread); isKnown {
+			m.replace(path, "invalid "+format, fmt.Sprintf("string is not valid %s", format))
+		}
+	}
+}
+
+// mutateNumber collects mutations of number violating range and multipleOf keywords.
+func (m *mutator) mutateNumber(sch map[string]any, path []any) {
+	lo, hasLo := boundOf(sch, "minimum", "exclusiveMinimum", math.Max)
+	if hasLo {
+		below := lo.value - 1
+		if lo.exclusive {
+			below = lo.value
+		}
+
+		m.replace(path, below, fmt.Sprintf("number %v is below allowed minimum", below))
+	}
+
+	hi, hasHi := boundOf(sch, "maximum", "exclusiveMaximum", math.Min)
+	if hasHi {
+		above := hi.value + 1
+		if hi.exclusive {
+			above = hi.value
+		}
+
+		m.replace(path, above, fmt.Sprintf("number %v is above allowed maximum", above))
+	}
+
+	if multipleOf, ok := toFloat(sch["multipleOf"]); ok && multipleOf > 0 {
+		notMultiple := multipleOf * 1.5
+		if hasLo && notMultiple < lo.value || hasHi && notMultiple > hi.value {
+			return
+		}
+
+		m.replace(path, notMultiple, fmt.Sprintf("number %v is not multiple of %v", notMultiple, multipleOf))
+	}
+}
+
+// replace adds mutation replacing value under path.
+func (m *mutator) replace(path []any, value any, reason string) {
+	m.add(setAt(m.root, path, value), fmt.Sprintf("%s: %s", formatPath(path), reason))
+}
+
+func (m *mutator) add(document any, description string) {
+	m.mutations = append(m.mutations, Mutation{Description: description, Document: document})
+}
+
+// outsideOfEnum returns value that is not present in enum.
+func outsideOfEnum(enum []any) any {
+	candidate := "invalid-enum-value"
+	for i := 0; containsValue(enum, candidate); i++ {
+		candidate = fmt.Sprintf("invalid-enum-value-%d", i)
+	}
+
+	return candidate
+}
+
+// setAt returns deep copy of document with value under path replaced.
+func setAt(document any, path []any, value any) any {
+	if len(path) == 0 {
+		return value
+	}
+
+	switch d := deepCopy(document).(type) {
+	case map[string]any:
+		d[path[0].(string)] = setAt(d[path[0].(string)], path[1:], value)
+
+		return d
+	case []any:
+		d[path[0].(int)] = setAt(d[path[0].(int)], path[1:], value)
+
+		return d
+	default:
+		return d
+	}
+}
+
+// removeAt returns deep copy of document with property under path removed.
+func removeAt(document any, path []any) any {
+	parent := path[:len(path)-1]
+	name := path[len(path)-1].(string)
+
+	doc := deepCopy(document)
+	target := doc
+	for _, token := range parent {
+		switch d := target.(type) {
+		case map[string]any:
+			target = d[token.(string)]
+		case []any:
+			target = d[token.(int)]
+		}
+	}
+
+	if obj, ok := target.(map[string]any); ok {
+		delete(obj, name)
+	}
+
+	return doc
+}
+
+// formatPath formats path into JSON path like notation.
+func formatPath(path []any) string {
+	var sb strings.Builder
+	sb.WriteString("$")
+	for _, token := range path {
+		switch t := token.(type) {
+		case int:
+			sb.WriteString(fmt.Sprintf("[%d]", t))
+		default:
+			sb.WriteString(fmt.Sprintf(".%v", t))
+		}
+	}
+
+	return sb.String()
+}
+
+// normalizeDocument converts document into structure produced by encoding/json.
+func normalizeDocument(document any) any {
+	raw, err := json.Marshal(document)
+	if err != nil {
+		return document
+	}
+
+	var normalized any
+	if err = json.Unmarshal(raw, &normalized); err != nil {
+		return document
+	}
+
+	return normalized
+}
+
+func deepCopy(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, val := range v {
+			result[key] = deepCopy(val)
+		}
+
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, val := range v {
+			result[i] = deepCopy(val)
+		}
+
+		return result
+	default:
+		return v
+	}
+}
+
+func copyMap(value map[string]any) map[string]any {
+	return deepCopy(value).(map[string]any)
+}
+
+func copyPath(path []any) []any {
+	return append(make([]any, 0, len(path)+1), path...)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,127 @@
+package schema
+
+import (
+	"fmt"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+
+	"github.com/pawelWritesCode/gdutils/pkg/mathutils"
+)
+
+// alphanumeric is set of characters used whenever generator may pick any character.
+const alphanumeric = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// maxUnboundedRepeat is maximum number of repetitions used for unbounded quantifiers like * or +.
+const maxUnboundedRepeat = 5
+
+// generateFromPattern generates string matching regular expression pattern.
+// GenerationModeMinimal picks the least number of repetitions, GenerationModeMaximal the biggest one.
+func generateFromPattern(pattern string, mode GenerationMode) (string, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", fmt.Errorf("could not parse pattern '%s', err: %w", pattern, err)
+	}
+
+	var sb strings.Builder
+	if err = writeFromRegexp(&sb, re.Simplify(), mode); err != nil {
+		return "", fmt.Errorf("could not generate string matching pattern '%s', err: %w", pattern, err)
+	}
+
+	return sb.String(), nil
+}
+
+// writeFromRegexp walks through parsed regular expression and writes into sb matching characters.
+func writeFromRegexp(sb *strings.Builder, re *syntax.Regexp, mode GenerationMode) error {
+	switch re.Op {
+	case syntax.OpNoMatch:
+		return fmt.Errorf("regular expression can't match any string")
+	case syntax.OpEmptyMatch, syntax.OpBeginLine, syntax.OpEndLine, syntax.OpBeginText, syntax.OpEndText,
+		syntax.OpWordBoundary, syntax.OpNoWordBoundary:
+		return nil
+	case syntax.OpLiteral:
+		sb.WriteString(string(re.Rune))
+	case syntax.OpCharClass:
+		r, err := pickFromCharClass(re.Rune)
+		if err != nil {
+			return err
+		}
+
+		sb.WriteRune(r)
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		sb.WriteByte(alphanumeric[mathutils.MustRandomInt(0, len(alphanumeric)-1)])
+	case syntax.OpCapture:
+		return writeFromRegexp(sb, re.Sub[0], mode)
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		from, to := repeatRange(re)
+		for i := pickInRange(from, to, mode); i > 0; i-- {
+			if err := writeFromRegexp(sb, re.Sub[0], mode); err != nil {
+				return err
+			}
+		}
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if err := writeFromRegexp(sb, sub, mode); err != nil {
+				return err
+			}
+		}
+	case syntax.OpAlternate:
+		return writeFromRegexp(sb, re.Sub[pickInRange(0, len(re.Sub)-1, GenerationModeRandom)], mode)
+	default:
+		return fmt.Errorf("unsupported regular expression operation: %s", re.Op)
+	}
+
+	return nil
+}
+
+// repeatRange returns allowed number of repetitions for quantifier operations.
+func repeatRange(re *syntax.Regexp) (int, int) {
+	switch re.Op {
+	case syntax.OpStar:
+		return 0, maxUnboundedRepeat
+	case syntax.OpPlus:
+		return 1, maxUnboundedRepeat
+	case syntax.OpQuest:
+		return 0, 1
+	}
+
+	if re.Max == -1 {
+		return re.Min, re.Min + maxUnboundedRepeat
+	}
+
+	return re.Min, re.Max
+}
+
+// pickFromCharClass picks rune from character class, printable ASCII characters are preferred.
+func pickFromCharClass(ranges []rune) (rune, error) {
+	if len(ranges) == 0 {
+		return 0, fmt.Errorf("empty character class")
+	}
+
+	printable := make([]rune, 0, 16)
+	for i := 0; i+1 < len(ranges); i += 2 {
+		for r := ranges[i]; r <= ranges[i+1] && r < unicode.MaxASCII; r++ {
+			if unicode.IsPrint(r) {
+				printable = append(printable, r)
+			}
+		}
+	}
+
+	if len(printable) > 0 {
+		return printable[mathutils.MustRandomInt(0, len(printable)-1)], nil
+	}
+
+	return ranges[0], nil
+}
+
+// pickInRange picks int from range <from, to> according to provided mode.
+func pickInRange(from, to int, mode GenerationMode) int {
+	switch mode {
+	case GenerationModeMinimal:
+		return from
+	case GenerationModeMaximal:
+		return to
+	default:
+		return mathutils.MustRandomInt(from, to)
+	}
+}
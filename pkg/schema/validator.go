@@ -87,7 +87,7 @@ func (jsv JSONSchemaReferenceXGValidator) Validate(document, schemaPath string)
 			errSum += err.String()
 		}
 
-		return errors.New(errSum)
+		return &v.ViolationError{Violations: errSum}
 	}
 
 	return nil
@@ -107,7 +107,7 @@ func (j JSONSchemaRawXGValidator) Validate(document, jsonSchema string) error {
 			errSum += err.String()
 		}
 
-		return errors.New(errSum)
+		return &v.ViolationError{Violations: errSum}
 	}
 
 	return nil
@@ -132,7 +132,7 @@ func (j JSONSchemaRawQIValidator) Validate(document, jsonSchema string) error {
 			errStr += e.Error() + " "
 		}
 
-		err = errors.New(errStr)
+		return &v.ViolationError{Violations: errStr}
 	}
 
 	return err
@@ -143,9 +143,10 @@ func TestJSONSchemaRawValidator_Validate(t *testing.T) {
 		jsonSchema string
 	}
 	tests := []struct {
-		name    string
-		args    args
-		wantErr bool
+		name          string
+		args          args
+		wantErr       bool
+		wantViolation bool
 	}{
 		{name: "valid data #1", args: args{
 			document:   document,
@@ -177,14 +178,20 @@ func TestJSONSchemaRawValidator_Validate(t *testing.T) {
     }
   }
 }`,
-		}, wantErr: true},
+		}, wantErr: true, wantViolation: true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			J := JSONSchemaRawXGValidator{}
-			if err := J.Validate(tt.args.document, tt.args.jsonSchema); (err != nil) != tt.wantErr {
+			err := J.Validate(tt.args.document, tt.args.jsonSchema)
+			if (err != nil) != tt.wantErr {
 				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
 			}
+
+			var violationErr *validator.ViolationError
+			if errors.As(err, &violationErr) != tt.wantViolation {
+				t.Errorf("Validate() error = %v, wantViolation %v", err, tt.wantViolation)
+			}
 		})
 	}
 }
@@ -0,0 +1,91 @@
+package timeutils
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// LayoutUnix describes time written as number of seconds elapsed since January 1, 1970 UTC.
+	LayoutUnix = "unix"
+
+	// LayoutUnixMilli describes time written as number of milliseconds elapsed since January 1, 1970 UTC.
+	LayoutUnixMilli = "unixmilli"
+)
+
+// Parse parses value into time.Time using layout. Layout may be any layout accepted by time.Parse,
+// LayoutUnix or LayoutUnixMilli. Empty layout means time.RFC3339.
+func Parse(value, layout string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+
+	switch layout {
+	case "":
+		return parseLayout(value, time.RFC3339)
+	case LayoutUnix, LayoutUnixMilli:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+			return time.Time{}, fmt.Errorf("could not parse '%s' as %s timestamp", value, layout)
+		}
+
+		if layout == LayoutUnixMilli {
+			number /= 1000
+		}
+
+		seconds, fraction := math.Modf(number)
+
+		return time.Unix(int64(seconds), int64(math.Round(fraction*1e9))).UTC(), nil
+	default:
+		return parseLayout(value, layout)
+	}
+}
+
+func parseLayout(value, layout string) (time.Time, error) {
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("could not parse '%s' using layout '%s', err: %w", value, layout, err)
+	}
+
+	return t, nil
+}
+
+// IsInTimezone checks whether t has the same UTC offset as timezone has at the moment of t.
+// Timezone may be IANA time zone name, for example: "Europe/Warsaw", "UTC" or offset, for example: "+02:00", "Z".
+func IsInTimezone(t time.Time, timezone string) (bool, error) {
+	_, actualOffset := t.Zone()
+
+	expectedOffset, err := timezoneOffset(t, strings.TrimSpace(timezone))
+	if err != nil {
+		return false, err
+	}
+
+	return actualOffset == expectedOffset, nil
+}
+
+func timezoneOffset(t time.Time, timezone string) (int, error) {
+	if timezone == "Z" {
+		return 0, nil
+	}
+
+	if strings.HasPrefix(timezone, "+") || strings.HasPrefix(timezone, "-") {
+		offset, err := time.Parse("-07:00", timezone)
+		if err != nil {
+			return 0, fmt.Errorf("could not parse timezone offset '%s', expected format: +hh:mm, err: %w", timezone, err)
+		}
+
+		_, seconds := offset.Zone()
+
+		return seconds, nil
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return 0, fmt.Errorf("could not load timezone '%s', err: %w", timezone, err)
+	}
+
+	_, seconds := t.In(location).Zone()
+
+	return seconds, nil
+}
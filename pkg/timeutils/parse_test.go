@@ -0,0 +1,71 @@
+package timeutils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		layout  string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "default layout", value: "2022-03-04T10:20:30Z", want: time.Date(2022, 3, 4, 10, 20, 30, 0, time.UTC)},
+		{name: "default layout with fraction", value: "2022-03-04T10:20:30.5Z", want: time.Date(2022, 3, 4, 10, 20, 30, 5e8, time.UTC)},
+		{name: "default layout - invalid", value: "2022-03-04", wantErr: true},
+		{name: "custom layout", value: "04.03.2022", layout: "02.01.2006", want: time.Date(2022, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{name: "unix seconds", value: "1646389230", layout: LayoutUnix, want: time.Date(2022, 3, 4, 10, 20, 30, 0, time.UTC)},
+		{name: "unix seconds with fraction", value: "1646389230.25", layout: LayoutUnix, want: time.Date(2022, 3, 4, 10, 20, 30, 25e7, time.UTC)},
+		{name: "unix milliseconds", value: "1646389230500", layout: LayoutUnixMilli, want: time.Date(2022, 3, 4, 10, 20, 30, 5e8, time.UTC)},
+		{name: "unix - invalid", value: "abc", layout: LayoutUnix, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.value, tt.layout)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("Parse() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsInTimezone(t *testing.T) {
+	summer, _ := Parse("2022-07-01T10:00:00+02:00", "")
+	utc, _ := Parse("2022-07-01T10:00:00Z", "")
+
+	tests := []struct {
+		name     string
+		t        time.Time
+		timezone string
+		want     bool
+		wantErr  bool
+	}{
+		{name: "offset", t: summer, timezone: "+02:00", want: true},
+		{name: "different offset", t: summer, timezone: "-02:00", want: false},
+		{name: "location", t: summer, timezone: "Europe/Warsaw", want: true},
+		{name: "different location", t: summer, timezone: "Europe/London", want: false},
+		{name: "UTC", t: utc, timezone: "UTC", want: true},
+		{name: "Z", t: utc, timezone: "Z", want: true},
+		{name: "unknown location", t: utc, timezone: "Mars/Olympus", wantErr: true},
+		{name: "invalid offset", t: utc, timezone: "+2", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsInTimezone(tt.t, tt.timezone)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("IsInTimezone() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("IsInTimezone() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
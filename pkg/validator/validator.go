@@ -7,6 +7,18 @@ type SchemaValidator interface {
 	Validate(document, schemaPath string) error
 }
 
+// ViolationError is returned by SchemaValidator when document is not valid against schema,
+// as opposed to errors caused by problems with loading document or schema.
+type ViolationError struct {
+	// Violations describes all violations of schema found in document.
+	Violations string
+}
+
+// Error returns description of violations.
+func (e *ViolationError) Error() string {
+	return e.Violations
+}
+
 // Validator describes validator
 type Validator interface {
 	// Validate validates in
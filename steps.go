@@ -10,12 +10,14 @@ import (
 	"io/ioutil"
 	"mime/multipart"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/goccy/go-yaml"
@@ -23,14 +25,24 @@ import (
 	"github.com/pawelWritesCode/df"
 	"moul.io/http2curl/v2"
 
+	"github.com/pawelWritesCode/gdutils/pkg/collection"
+	"github.com/pawelWritesCode/gdutils/pkg/comparator"
 	"github.com/pawelWritesCode/gdutils/pkg/httpcache"
+	"github.com/pawelWritesCode/gdutils/pkg/httpctx"
+	"github.com/pawelWritesCode/gdutils/pkg/jwt"
+	"github.com/pawelWritesCode/gdutils/pkg/load"
 	"github.com/pawelWritesCode/gdutils/pkg/mathutils"
 	"github.com/pawelWritesCode/gdutils/pkg/osutils"
+	"github.com/pawelWritesCode/gdutils/pkg/schema"
 	"github.com/pawelWritesCode/gdutils/pkg/timeutils"
 	"github.com/pawelWritesCode/gdutils/pkg/types"
 	"github.com/pawelWritesCode/gdutils/pkg/validator"
 )
 
+// defaultBodySnippetLimit is the maximum number of response body bytes included in error messages,
+// when debugger does not limit number of bytes it prints.
+const defaultBodySnippetLimit = 3072
+
 // BodyHeaders is entity that holds information about request body and request headers.
 type BodyHeaders struct {
 
@@ -108,28 +120,9 @@ func (apiCtx *APIContext) RequestSendWithBodyAndHeaders(method, urlTemplate stri
 		req.Header.Set(headerName, headerValue)
 	}
 
-	if apiCtx.Debugger.IsOn() {
-		command, _ := http2curl.GetCurlCommand(req)
-		apiCtx.Debugger.Print(command.String())
-	}
-
-	apiCtx.Cache.Save(httpcache.LastHTTPRequestTimestamp, time.Now())
-
-	resp, err := apiCtx.RequestDoer.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to send request %s %s, reason: %w", req.Method, req.URL.String(), err)
-	}
-
-	apiCtx.Cache.Save(httpcache.LastHTTPResponseTimestamp, time.Now())
-	apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, resp)
-
-	if apiCtx.Debugger.IsOn() {
-		respBody, _ := apiCtx.GetLastResponseBody()
-		apiCtx.Debugger.Print(fmt.Sprintf("%s %s (%d)", req.Method, req.URL.String(), resp.StatusCode))
-		apiCtx.Debugger.Print(string(respBody))
-	}
+	_, err = apiCtx.sendRequest(req)
 
-	return nil
+	return err
 }
 
 // RequestPrepare prepares new request and saves it in cache under cacheKey
@@ -337,25 +330,296 @@ func (apiCtx *APIContext) RequestSend(cacheKey string) error {
 		return fmt.Errorf("could not obtain prepared request, err: %w", err)
 	}
 
+	_, err = apiCtx.sendRequest(req)
+
+	return err
+}
+
+// RequestSendCORSPreflight sends CORS preflight request: OPTIONS request with Origin header equal to originTemplate,
+// Access-Control-Request-Method header equal to method and Access-Control-Request-Headers header holding
+// comma separated list of header names from headersTemplate, which may be empty.
+func (apiCtx *APIContext) RequestSendCORSPreflight(urlTemplate, originTemplate, method, headersTemplate string) error {
+	url, err := apiCtx.TemplateEngine.Replace(urlTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'url' template, err: %w", err)
+	}
+
+	origin, err := apiCtx.TemplateEngine.Replace(originTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'origin' template, err: %w", err)
+	}
+
+	headers, err := apiCtx.TemplateEngine.Replace(headersTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'headers' template, err: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodOptions, url, nil)
+	if err != nil {
+		return fmt.Errorf("can't create request due to err: %w", err)
+	}
+
+	req.Header.Set("Origin", origin)
+	req.Header.Set(httpctx.HeaderRequestMethod, method)
+	if names := splitList(headers); len(names) > 0 {
+		req.Header.Set(httpctx.HeaderRequestHeaders, strings.ToLower(strings.Join(names, ",")))
+	}
+
+	_, err = apiCtx.sendRequest(req)
+
+	return err
+}
+
+// RequestSendIfNoneMatch sends again previously prepared request with If-None-Match header equal to ETag header
+// of last HTTP(s) response. When resource did not change, server should respond with status code 304.
+func (apiCtx *APIContext) RequestSendIfNoneMatch(cacheKey string) error {
+	return apiCtx.requestSendConditional(cacheKey, "If-None-Match", "ETag")
+}
+
+// RequestSendIfModifiedSince sends again previously prepared request with If-Modified-Since header equal to
+// Last-Modified header of last HTTP(s) response. When resource did not change, server should respond with status code 304.
+func (apiCtx *APIContext) RequestSendIfModifiedSince(cacheKey string) error {
+	return apiCtx.requestSendConditional(cacheKey, "If-Modified-Since", "Last-Modified")
+}
+
+// RequestSendPaginatedByLink sends previously prepared request and follows URLs from Link header with rel="next"
+// of consecutive responses until there is no next page or maxPages pages were fetched, 0 means no limit.
+// Elements of node obtained using itemsExprTemplate from every page are saved as one array under itemsCacheKey,
+// so node assertions may be run over whole dataset after SetCachedDocumentAsNodeSource using expression pointing
+// at root node, for example: $[*]. Last HTTP(s) response is response with last fetched page.
+func (apiCtx *APIContext) RequestSendPaginatedByLink(cacheKey string, dataFormat df.DataFormat, itemsExprTemplate string, maxPages int, itemsCacheKey string) error {
+	return apiCtx.requestSendPaginatedGeneral(cacheKey, dataFormat, itemsExprTemplate, maxPages, itemsCacheKey,
+		func(req *http.Request, resp *http.Response, body []byte, items []any) (*url.URL, error) {
+			link, hasNext := httpctx.LinkByRel(resp.Header.Values("Link"), "next")
+			if !hasNext {
+				return nil, nil
+			}
+
+			return req.URL.Parse(link)
+		})
+}
+
+// RequestSendPaginatedByCursor sends previously prepared request and next pages with query parameter cursorParam
+// equal to value of node obtained using cursorExprTemplate from previous response, until cursor node is missing,
+// null or empty or maxPages pages were fetched, 0 means no limit. Collected items are saved like in RequestSendPaginatedByLink.
+func (apiCtx *APIContext) RequestSendPaginatedByCursor(cacheKey string, dataFormat df.DataFormat, itemsExprTemplate, cursorExprTemplate, cursorParam string, maxPages int, itemsCacheKey string) error {
+	cursorExpr, err := apiCtx.TemplateEngine.Replace(cursorExprTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'cursor expression' template, err: %w", err)
+	}
+
+	return apiCtx.requestSendPaginatedGeneral(cacheKey, dataFormat, itemsExprTemplate, maxPages, itemsCacheKey,
+		func(req *http.Request, resp *http.Response, body []byte, items []any) (*url.URL, error) {
+			cursor, err := apiCtx.getNode(body, cursorExpr, dataFormat, types.Any)
+			if err != nil {
+				var notFoundErr *NodeNotFoundError
+				if errors.As(err, &notFoundErr) {
+					return nil, nil
+				}
+
+				return nil, err
+			}
+
+			if cursor == nil || cursor == "" {
+				return nil, nil
+			}
+
+			return withQueryParam(req.URL, cursorParam, apiCtx.nodeValueToString(cursor)), nil
+		})
+}
+
+// RequestSendPaginatedByPage sends previously prepared request and next pages with query parameter pageParam
+// incremented by 1, starting from its value in prepared request or 1, until page without items is returned
+// or maxPages pages were fetched, 0 means no limit. Collected items are saved like in RequestSendPaginatedByLink.
+func (apiCtx *APIContext) RequestSendPaginatedByPage(cacheKey string, dataFormat df.DataFormat, itemsExprTemplate, pageParam string, maxPages int, itemsCacheKey string) error {
+	return apiCtx.requestSendPaginatedGeneral(cacheKey, dataFormat, itemsExprTemplate, maxPages, itemsCacheKey,
+		func(req *http.Request, resp *http.Response, body []byte, items []any) (*url.URL, error) {
+			if len(items) == 0 {
+				return nil, nil
+			}
+
+			page, err := queryParamInt(req.URL, pageParam, 1)
+			if err != nil {
+				return nil, err
+			}
+
+			return withQueryParam(req.URL, pageParam, strconv.Itoa(page+1)), nil
+		})
+}
+
+// RequestSendPaginatedByOffset sends previously prepared request and next pages with query parameter offsetParam
+// incremented by number of items of previous page, starting from its value in prepared request or 0, until page
+// without items is returned or maxPages pages were fetched, 0 means no limit.
+// Collected items are saved like in RequestSendPaginatedByLink.
+func (apiCtx *APIContext) RequestSendPaginatedByOffset(cacheKey string, dataFormat df.DataFormat, itemsExprTemplate, offsetParam string, maxPages int, itemsCacheKey string) error {
+	return apiCtx.requestSendPaginatedGeneral(cacheKey, dataFormat, itemsExprTemplate, maxPages, itemsCacheKey,
+		func(req *http.Request, resp *http.Response, body []byte, items []any) (*url.URL, error) {
+			if len(items) == 0 {
+				return nil, nil
+			}
+
+			offset, err := queryParamInt(req.URL, offsetParam, 0)
+			if err != nil {
+				return nil, err
+			}
+
+			return withQueryParam(req.URL, offsetParam, strconv.Itoa(offset+len(items))), nil
+		})
+}
+
+// RequestSendConcurrently sends count copies of previously prepared request at the same time and saves their
+// responses, in order of copies, under httpcache.LastHTTPResponsesCacheKey. Last HTTP(s) response is not changed.
+// Responses may be checked with AssertResponsesStatusCodesAre and AssertResponsesStatusCodeCountIs.
+func (apiCtx *APIContext) RequestSendConcurrently(cacheKey string, count int) error {
+	if count < 1 {
+		return fmt.Errorf("number of requests should be greater than 0, got %d", count)
+	}
+
+	req, err := apiCtx.GetPreparedRequest(cacheKey)
+	if err != nil {
+		return fmt.Errorf("could not obtain prepared request, err: %w", err)
+	}
+
+	body, err := readPreparedRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	newCopy := func() *http.Request {
+		if body == nil {
+			return req.Clone(req.Context())
+		}
+
+		return cloneRequestWithBody(req, body)
+	}
+
 	if apiCtx.Debugger.IsOn() {
-		command, _ := http2curl.GetCurlCommand(req)
-		apiCtx.Debugger.Print(command.String())
+		command, _ := http2curl.GetCurlCommand(newCopy())
+		apiCtx.Debugger.Print(fmt.Sprintf("sending %d copies of request concurrently: %s", count, command.String()))
 	}
 
-	apiCtx.Cache.Save(httpcache.LastHTTPRequestTimestamp, time.Now())
+	responses := make([]*http.Response, count)
+	errs := make([]error, count)
+	var wg sync.WaitGroup
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			resp, err := apiCtx.RequestDoer.Do(newCopy())
+			if err != nil {
+				errs[i] = err
+				return
+			}
+
+			respBody, err := httpctx.ReadBody(resp.Body, httpctx.ResponseBodyOptions{MaxSize: apiCtx.ResponseBodyOptions.MaxSize})
+			resp.Body.Close()
+			if err != nil {
+				errs[i] = fmt.Errorf("could not read response body, err: %w", err)
+				return
+			}
+
+			resp.Body = respBody
+			responses[i] = resp
+		}(i)
+	}
+
+	wg.Wait()
+
+	failures := make([]string, 0)
+	for i, err := range errs {
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("request %d: %v", i+1, err))
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("%d of %d concurrent requests %s %s failed:\n%s", len(failures), count, req.Method, req.URL, strings.Join(failures, "\n"))
+	}
+
+	apiCtx.Cache.Save(httpcache.LastHTTPResponsesCacheKey, responses)
+
+	if apiCtx.Debugger.IsOn() {
+		apiCtx.Debugger.Print(fmt.Sprintf("status codes of concurrent requests: %s", httpctx.FormatStatusCodeCounts(statusCodeCounts(responses))))
+	}
+
+	return nil
+}
 
-	resp, err := apiCtx.RequestDoer.Do(req)
+// RequestSendWithLoadProfile sends copies of previously prepared request according to load profile and saves
+// summary of their latencies, error rate and throughput as load.Summary under summaryCacheKey,
+// so its fields may be used in templates, for example: {{.SUMMARY.P95}}. Last HTTP(s) response is not changed.
+// profileTemplate should be YAML or JSON deserializable on load.Profile, for example:
+// {"concurrency": 10, "rate": 50, "duration": "10s", "iterations": 1000}. Requests that failed or were answered
+// with status code 400 or greater are counted as errors. Summary may be checked with AssertLoadLatencyPercentileIsAtMost,
+// AssertLoadErrorRateIsAtMost and AssertLoadThroughputIsAtLeast.
+func (apiCtx *APIContext) RequestSendWithLoadProfile(cacheKey, profileTemplate, summaryCacheKey string) error {
+	var profile load.Profile
+
+	profileString, err := apiCtx.TemplateEngine.Replace(profileTemplate, apiCtx.Cache.All())
 	if err != nil {
-		return fmt.Errorf("failed to send request %s %s, reason: %w", req.Method, req.URL.String(), err)
+		return fmt.Errorf("template engine has problem with 'profile' template, err: %w", err)
 	}
 
-	apiCtx.Cache.Save(httpcache.LastHTTPResponseTimestamp, time.Now())
-	apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, resp)
+	req, err := apiCtx.GetPreparedRequest(cacheKey)
+	if err != nil {
+		return fmt.Errorf("could not obtain prepared request, err: %w", err)
+	}
+
+	profileBytes := []byte(profileString)
+	if df.IsJSON(profileBytes) {
+		if err = apiCtx.Serializers.JSON.Deserialize(profileBytes, &profile); err != nil {
+			return fmt.Errorf("could not deserialize provided load profile, err: %w", err)
+		}
+	} else if df.IsYAML(profileBytes) {
+		if err = apiCtx.Serializers.YAML.Deserialize(profileBytes, &profile); err != nil {
+			return fmt.Errorf("could not deserialize provided load profile, err: %w", err)
+		}
+	} else if df.IsXML(profileBytes) {
+		return fmt.Errorf("this method does not support data in format: %s", df.XML)
+	} else {
+		return fmt.Errorf("could not recognize data format. Check your data, maybe you have typo somewhere or syntax error. Supported formats are: %s, %s", df.JSON, df.YAML)
+	}
+
+	body, err := readPreparedRequestBody(req)
+	if err != nil {
+		return err
+	}
+
+	newCopy := func() *http.Request {
+		if body == nil {
+			return req.Clone(req.Context())
+		}
+
+		return cloneRequestWithBody(req, body)
+	}
 
 	if apiCtx.Debugger.IsOn() {
-		respBody, _ := apiCtx.GetLastResponseBody()
-		apiCtx.Debugger.Print(fmt.Sprintf("%s %s (%d)", req.Method, req.URL.String(), resp.StatusCode))
-		apiCtx.Debugger.Print(string(respBody))
+		command, _ := http2curl.GetCurlCommand(newCopy())
+		apiCtx.Debugger.Print(fmt.Sprintf("sending request with load profile %+v: %s", profile, command.String()))
+	}
+
+	summary, err := load.Run(profile, func() (int, error) {
+		resp, err := apiCtx.RequestDoer.Do(newCopy())
+		if err != nil {
+			return 0, err
+		}
+
+		defer resp.Body.Close()
+		if _, err = io.Copy(ioutil.Discard, resp.Body); err != nil {
+			return 0, fmt.Errorf("could not read response body, err: %w", err)
+		}
+
+		return resp.StatusCode, nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not send request %s %s with load profile, err: %w", req.Method, req.URL, err)
+	}
+
+	apiCtx.Cache.Save(summaryCacheKey, summary)
+
+	if apiCtx.Debugger.IsOn() {
+		apiCtx.Debugger.Print(fmt.Sprintf("load summary: %s, status codes: %s", summary, httpctx.FormatStatusCodeCounts(summary.StatusCodes)))
 	}
 
 	return nil
@@ -465,22 +729,41 @@ func (apiCtx *APIContext) GenerateTimeAndTravel(timeDirection timeutils.TimeDire
 	return apiCtx.GetTimeAndTravel(time.Now(), timeDirection, timeDuration, cacheKey)
 }
 
+// GenerateFromSchemaByString generates data valid against JSON schema provided in schemaTemplate
+// and saves it in JSON format under given cacheKey. mode decides whether minimal, maximal or random data is generated.
+func (apiCtx *APIContext) GenerateFromSchemaByString(schemaTemplate string, mode schema.GenerationMode, cacheKey string) error {
+	return apiCtx.generateFromSchemaGeneral(schemaTemplate, mode, cacheKey, apiCtx.SchemaGenerators.StringGenerator)
+}
+
+// GenerateFromSchemaByReference generates data valid against JSON schema provided in referenceTemplate
+// and saves it in JSON format under given cacheKey. referenceTemplate may be: URL or full/relative path.
+// mode decides whether minimal, maximal or random data is generated.
+func (apiCtx *APIContext) GenerateFromSchemaByReference(referenceTemplate string, mode schema.GenerationMode, cacheKey string) error {
+	return apiCtx.generateFromSchemaGeneral(referenceTemplate, mode, cacheKey, apiCtx.SchemaGenerators.ReferenceGenerator)
+}
+
 // AssertStatusCodeIs compare last response status code with given in argument.
-func (apiCtx *APIContext) AssertStatusCodeIs(code int) error {
+// Failure message contains last response body, limited to debugger's bytes limit.
+func (apiCtx *APIContext) AssertStatusCodeIs(code int) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertStatusCodeIs", code)
+
 	lastResponse, err := apiCtx.GetLastResponse()
 	if err != nil {
 		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
 	}
 
 	if lastResponse.StatusCode != code {
-		return fmt.Errorf("expected status code %d, but got %d", code, lastResponse.StatusCode)
+		return &AssertionError{Expression: StatusCodeExpression, Expected: code, Actual: lastResponse.StatusCode, Err: fmt.Errorf("expected status code %d, but got %d, %s", code, lastResponse.StatusCode, apiCtx.lastResponseBodySnippet())}
 	}
 
 	return nil
 }
 
 // AssertStatusCodeIsNot asserts that last response status code is not provided.
-func (apiCtx *APIContext) AssertStatusCodeIsNot(code int) error {
+// Failure message contains last response body, limited to debugger's bytes limit.
+func (apiCtx *APIContext) AssertStatusCodeIsNot(code int) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertStatusCodeIsNot", code)
+
 	lastResponse, err := apiCtx.GetLastResponse()
 	if err != nil {
 		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
@@ -490,12 +773,81 @@ func (apiCtx *APIContext) AssertStatusCodeIsNot(code int) error {
 		return nil
 	}
 
-	return fmt.Errorf("expected status code different than %d, but got %d", code, lastResponse.StatusCode)
+	return &AssertionError{Expression: StatusCodeExpression, Actual: lastResponse.StatusCode, Err: fmt.Errorf("expected status code different than %d, but got %d, %s", code, lastResponse.StatusCode, apiCtx.lastResponseBodySnippet())}
+}
+
+// AssertStatusCodeIsOfClass asserts that last response status code belongs to given class, for example: 2xx or 4xx.
+// Failure message contains last response body, limited to debugger's bytes limit.
+func (apiCtx *APIContext) AssertStatusCodeIsOfClass(classTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertStatusCodeIsOfClass", classTemplate)
+
+	class, err := apiCtx.TemplateEngine.Replace(classTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'class' template, err: %w", err)
+	}
+
+	lastResponse, err := apiCtx.GetLastResponse()
+	if err != nil {
+		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
+	}
+
+	isOfClass, err := httpctx.IsOfStatusClass(lastResponse.StatusCode, class)
+	if err != nil {
+		return err
+	}
+
+	if !isOfClass {
+		return &AssertionError{Expression: StatusCodeExpression, Expected: class, Actual: lastResponse.StatusCode,
+			Err: fmt.Errorf("expected status code of class %s, but got %d, %s", class, lastResponse.StatusCode, apiCtx.lastResponseBodySnippet())}
+	}
+
+	return nil
+}
+
+// AssertStatusCodeIsOneOf asserts that last response status code is one of comma separated codes, for example: "200, 201, 204".
+// Failure message contains last response body, limited to debugger's bytes limit.
+func (apiCtx *APIContext) AssertStatusCodeIsOneOf(codesTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertStatusCodeIsOneOf", codesTemplate)
+
+	codesString, err := apiCtx.TemplateEngine.Replace(codesTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'codes' template, err: %w", err)
+	}
+
+	codes := make([]int, 0)
+	for _, c := range splitList(codesString) {
+		code, err := strconv.Atoi(c)
+		if err != nil {
+			return fmt.Errorf("status code '%s' is not valid integer, err: %w", c, err)
+		}
+
+		codes = append(codes, code)
+	}
+
+	if len(codes) == 0 {
+		return fmt.Errorf("provide at least one status code")
+	}
+
+	lastResponse, err := apiCtx.GetLastResponse()
+	if err != nil {
+		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
+	}
+
+	for _, code := range codes {
+		if lastResponse.StatusCode == code {
+			return nil
+		}
+	}
+
+	return &AssertionError{Expression: StatusCodeExpression, Expected: codes, Actual: lastResponse.StatusCode,
+		Err: fmt.Errorf("expected status code to be one of %v, but got %d, %s", codes, lastResponse.StatusCode, apiCtx.lastResponseBodySnippet())}
 }
 
 // AssertResponseFormatIs checks whether last response body has given data format.
 // Available data formats are listed in format package.
-func (apiCtx *APIContext) AssertResponseFormatIs(dataFormat df.DataFormat) error {
+func (apiCtx *APIContext) AssertResponseFormatIs(dataFormat df.DataFormat) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseFormatIs", dataFormat)
+
 	body, err := apiCtx.GetLastResponseBody()
 	if err != nil {
 		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
@@ -507,31 +859,31 @@ func (apiCtx *APIContext) AssertResponseFormatIs(dataFormat df.DataFormat) error
 			return nil
 		}
 
-		return fmt.Errorf("response body doesn't have format %s", df.JSON)
+		return &AssertionError{Expected: df.JSON, Err: fmt.Errorf("response body doesn't have format %s", df.JSON)}
 	case df.YAML:
 		if df.IsYAML(body) {
 			return nil
 		}
 
-		return fmt.Errorf("response body doesn't have format %s", df.YAML)
+		return &AssertionError{Expected: df.YAML, Err: fmt.Errorf("response body doesn't have format %s", df.YAML)}
 	case df.XML:
 		if df.IsXML(body) {
 			return nil
 		}
 
-		return fmt.Errorf("response body doesn't have format %s", df.XML)
+		return &AssertionError{Expected: df.XML, Err: fmt.Errorf("response body doesn't have format %s", df.XML)}
 	case df.HTML:
 		if df.IsHTML(body) {
 			return nil
 		}
 
-		return fmt.Errorf("response body doesn't have format %s", df.HTML)
+		return &AssertionError{Expected: df.HTML, Err: fmt.Errorf("response body doesn't have format %s", df.HTML)}
 	case df.PlainText:
 		if df.IsPlainText(body) {
 			return nil
 		}
 
-		return fmt.Errorf("response body doesn't have format %s", df.PlainText)
+		return &AssertionError{Expected: df.PlainText, Err: fmt.Errorf("response body doesn't have format %s", df.PlainText)}
 	default:
 		return fmt.Errorf("unknown last response body data format, available formats: %s, %s, %s, %s, %s",
 			df.JSON, df.YAML, df.XML, df.HTML, df.PlainText)
@@ -540,7 +892,9 @@ func (apiCtx *APIContext) AssertResponseFormatIs(dataFormat df.DataFormat) error
 
 // AssertResponseFormatIsNot checks whether last response body has not given data format.
 // Available data formats are listed in format package.
-func (apiCtx *APIContext) AssertResponseFormatIsNot(dataFormat df.DataFormat) error {
+func (apiCtx *APIContext) AssertResponseFormatIsNot(dataFormat df.DataFormat) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseFormatIsNot", dataFormat)
+
 	body, err := apiCtx.GetLastResponseBody()
 	if err != nil {
 		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
@@ -549,31 +903,31 @@ func (apiCtx *APIContext) AssertResponseFormatIsNot(dataFormat df.DataFormat) er
 	switch dataFormat {
 	case df.JSON:
 		if df.IsJSON(body) {
-			return fmt.Errorf("response body has format %s", df.JSON)
+			return &AssertionError{Actual: df.JSON, Err: fmt.Errorf("response body has format %s", df.JSON)}
 		}
 
 		return nil
 	case df.YAML:
 		if df.IsYAML(body) {
-			return fmt.Errorf("response body has format %s", df.YAML)
+			return &AssertionError{Actual: df.YAML, Err: fmt.Errorf("response body has format %s", df.YAML)}
 		}
 
 		return nil
 	case df.XML:
 		if df.IsXML(body) {
-			return fmt.Errorf("response body has format %s", df.XML)
+			return &AssertionError{Actual: df.XML, Err: fmt.Errorf("response body has format %s", df.XML)}
 		}
 
 		return nil
 	case df.HTML:
 		if df.IsHTML(body) {
-			return fmt.Errorf("response body has format %s", df.HTML)
+			return &AssertionError{Actual: df.HTML, Err: fmt.Errorf("response body has format %s", df.HTML)}
 		}
 
 		return nil
 	case df.PlainText:
 		if df.IsPlainText(body) {
-			return fmt.Errorf("response body has format %s", df.PlainText)
+			return &AssertionError{Actual: df.PlainText, Err: fmt.Errorf("response body has format %s", df.PlainText)}
 		}
 
 		return nil
@@ -583,83 +937,236 @@ func (apiCtx *APIContext) AssertResponseFormatIsNot(dataFormat df.DataFormat) er
 	}
 }
 
-// AssertNodeExists checks whether last response body contains given node.
-// expr should be valid according to injected PathFinder for given data format
-func (apiCtx *APIContext) AssertNodeExists(dataFormat df.DataFormat, exprTemplate string) error {
-	body, err := apiCtx.GetLastResponseBody()
-	if err != nil {
-		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
-	}
+// AssertResponseBodyEquals checks whether last HTTP(s) response body is structurally equal to document from expectedTemplate.
+// Both documents should be in provided dataFormat, available formats are: JSON, YAML and XML.
+// ignorePathsTemplate may contain comma separated paths of nodes skipped during comparison, for example: "$.id, $.items[*].createdAt".
+// Array order insensitivity and numeric tolerance may be configured with APIContext.ComparisonOptions.
+func (apiCtx *APIContext) AssertResponseBodyEquals(dataFormat df.DataFormat, expectedTemplate, ignorePathsTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseBodyEquals", dataFormat, expectedTemplate, ignorePathsTemplate)
 
-	expr, err := apiCtx.TemplateEngine.Replace(exprTemplate, apiCtx.Cache.All())
+	ignorePaths, err := apiCtx.TemplateEngine.Replace(ignorePathsTemplate, apiCtx.Cache.All())
 	if err != nil {
-		return fmt.Errorf("template engine has problem with 'form' template, err: %w", err)
+		return fmt.Errorf("template engine has problem with 'ignore paths' template, err: %w", err)
 	}
 
-	_, err = apiCtx.getNode(body, expr, dataFormat, types.Any)
+	options := apiCtx.ComparisonOptions
+	options.IgnorePaths = append(append([]string{}, options.IgnorePaths...), splitList(ignorePaths)...)
+
+	differences, err := apiCtx.compareResponseBodyGeneral(dataFormat, expectedTemplate, options)
 	if err != nil {
-		if apiCtx.Debugger.IsOn() {
-			apiCtx.Debugger.Print(fmt.Sprintf("last response body:\n\n%s", body))
-		}
+		return err
+	}
 
-		return fmt.Errorf("node '%s' could not be found within last response body, reason: %w", expr, err)
+	if len(differences) > 0 {
+		return &AssertionError{DataFormat: dataFormat,
+			Err: fmt.Errorf("last HTTP(s) response body is not equal to expected document, found %d differences:\n%s", len(differences), comparator.FormatDifferences(differences))}
 	}
 
 	return nil
 }
 
-// AssertNodeNotExists checks whether last response body does not contain given node.
-// expr should be valid according to injected PathFinder for given data format
-func (apiCtx *APIContext) AssertNodeNotExists(dataFormat df.DataFormat, exprTemplate string) error {
-	body, err := apiCtx.GetLastResponseBody()
-	if err != nil {
-		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
-	}
-
-	expr, err := apiCtx.TemplateEngine.Replace(exprTemplate, apiCtx.Cache.All())
-	if err != nil {
-		return fmt.Errorf("template engine has problem with 'form' template, err: %w", err)
-	}
+// AssertResponseBodyContains checks whether last HTTP(s) response body contains every node from document
+// provided in expectedSubsetTemplate, nodes not present in expected document are ignored.
+// Both documents should be in provided dataFormat, available formats are: JSON, YAML and XML.
+// Expected document may contain placeholders instead of values: "<any string>", "<uuid>", "<number>" or "<regexp:pattern>",
+// in XML documents placeholders should be escaped, for example: &lt;uuid&gt;
+func (apiCtx *APIContext) AssertResponseBodyContains(dataFormat df.DataFormat, expectedSubsetTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseBodyContains", dataFormat, expectedSubsetTemplate)
 
-	if body == nil || len(body) == 0 {
-		return fmt.Errorf("provided nil body")
-	}
+	options := apiCtx.ComparisonOptions
+	options.Subset = true
+	options.Placeholders = true
 
-	_, err = apiCtx.getNode(body, expr, dataFormat, types.Any)
+	differences, err := apiCtx.compareResponseBodyGeneral(dataFormat, expectedSubsetTemplate, options)
 	if err != nil {
-		return nil
+		return err
 	}
 
-	if apiCtx.Debugger.IsOn() {
-		apiCtx.Debugger.Print(fmt.Sprintf("last response body:\n\n%s", body))
+	if len(differences) > 0 {
+		return &AssertionError{DataFormat: dataFormat,
+			Err: fmt.Errorf("last HTTP(s) response body does not contain expected document, found %d differences:\n%s", len(differences), comparator.FormatDifferences(differences))}
 	}
 
-	return fmt.Errorf("%s node '%s' exists", dataFormat, expr)
+	return nil
 }
 
-// AssertNodesExist checks whether last request body has keys defined in string separated by comma
-// nodeExprs should be valid according to injected PathFinder expressions separated by comma (,)
-func (apiCtx *APIContext) AssertNodesExist(dataFormat df.DataFormat, expressionsTemplate string) error {
-	expressions, err := apiCtx.TemplateEngine.Replace(expressionsTemplate, apiCtx.Cache.All())
+// AssertResponseBodyMatchesSnapshot compares last HTTP(s) response body with snapshot stored in file under snapshotPathTemplate.
+// Response body is normalized: pretty-printed in provided dataFormat (JSON, YAML or XML) with sorted keys and with nodes
+// from comma separated maskedPathsTemplate replaced by "<masked>", for example: "$.id, $.items[*].createdAt".
+// When environment variable GDUTILS_UPDATE_SNAPSHOTS is set to true, normalized response body is written
+// to snapshot file instead. Missing snapshot file is an error, unless snapshots are updated.
+func (apiCtx *APIContext) AssertResponseBodyMatchesSnapshot(dataFormat df.DataFormat, snapshotPathTemplate, maskedPathsTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseBodyMatchesSnapshot", dataFormat, snapshotPathTemplate, maskedPathsTemplate)
+
+	if dataFormat != df.JSON && dataFormat != df.YAML && dataFormat != df.XML {
+		return fmt.Errorf("this method does not support data in format: %s", dataFormat)
+	}
+
+	snapshotPath, err := apiCtx.TemplateEngine.Replace(snapshotPathTemplate, apiCtx.Cache.All())
 	if err != nil {
-		return fmt.Errorf("template engine has problem with 'form' template, err: %w", err)
+		return fmt.Errorf("template engine has problem with 'snapshot path' template, err: %w", err)
 	}
 
-	keysSlice := strings.Split(expressions, ",")
+	maskedPaths, err := apiCtx.TemplateEngine.Replace(maskedPathsTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'masked paths' template, err: %w", err)
+	}
 
 	body, err := apiCtx.GetLastResponseBody()
 	if err != nil {
 		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
 	}
 
-	errs := make([]error, 0, len(keysSlice))
-	for _, key := range keysSlice {
-		trimmedKey := strings.TrimSpace(key)
-
-		_, err := apiCtx.getNode(body, trimmedKey, dataFormat, types.Any)
-		if err != nil {
-			errs = append(errs, fmt.Errorf("node '%s', err: %s", trimmedKey, err.Error()))
-		}
+	document, err := comparator.Decode(body, dataFormat)
+	if err != nil {
+		return &AssertionError{DataFormat: dataFormat, Err: fmt.Errorf("could not decode last HTTP(s) response body, err: %w", err)}
+	}
+
+	actual, err := comparator.Mask(document, splitList(maskedPaths))
+	if err != nil {
+		return fmt.Errorf("could not mask last HTTP(s) response body nodes, err: %w", err)
+	}
+
+	snapshot, err := os.ReadFile(snapshotPath)
+	if (err == nil || errors.Is(err, os.ErrNotExist)) && comparator.ShouldUpdateSnapshots() {
+		normalized, err := comparator.Encode(actual, dataFormat)
+		if err != nil {
+			return fmt.Errorf("could not normalize last HTTP(s) response body, err: %w", err)
+		}
+
+		if err = os.MkdirAll(filepath.Dir(snapshotPath), 0o755); err != nil {
+			return fmt.Errorf("could not create directory for snapshot %s, err: %w", snapshotPath, err)
+		}
+
+		if err = os.WriteFile(snapshotPath, normalized, 0o644); err != nil {
+			return fmt.Errorf("could not write snapshot %s, err: %w", snapshotPath, err)
+		}
+
+		if apiCtx.Debugger.IsOn() {
+			apiCtx.Debugger.Print(fmt.Sprintf("snapshot %s was written:\n\n%s", snapshotPath, normalized))
+		}
+
+		return nil
+	}
+
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("snapshot %s does not exist, to create it set environment variable %s=true", snapshotPath, comparator.UpdateSnapshotsEnv)
+	}
+
+	if err != nil {
+		return fmt.Errorf("could not read snapshot %s, err: %w", snapshotPath, err)
+	}
+
+	expected, err := comparator.Decode(snapshot, dataFormat)
+	if err != nil {
+		return fmt.Errorf("could not decode snapshot %s, err: %w", snapshotPath, err)
+	}
+
+	options := apiCtx.ComparisonOptions
+	options.ScalarsAsStrings = dataFormat == df.XML
+
+	differences, err := comparator.Compare(expected, actual, options)
+	if err != nil {
+		return err
+	}
+
+	if len(differences) > 0 {
+		return &AssertionError{DataFormat: dataFormat,
+			Err: fmt.Errorf("last HTTP(s) response body does not match snapshot %s, found %d differences:\n%s\nto update snapshots set environment variable %s=true",
+				snapshotPath, len(differences), comparator.FormatDifferences(differences), comparator.UpdateSnapshotsEnv)}
+	}
+
+	return nil
+}
+
+// AssertNodeExists checks whether last response body contains given node.
+// expr should be valid according to injected PathFinder for given data format
+func (apiCtx *APIContext) AssertNodeExists(dataFormat df.DataFormat, exprTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeExists", dataFormat, exprTemplate)
+
+	body, err := apiCtx.getNodeSource()
+	if err != nil {
+		return err
+	}
+
+	expr, err := apiCtx.TemplateEngine.Replace(exprTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'form' template, err: %w", err)
+	}
+
+	_, err = apiCtx.getNode(body, expr, dataFormat, types.Any)
+	if err != nil {
+		if apiCtx.Debugger.IsOn() {
+			apiCtx.Debugger.Print(fmt.Sprintf("last response body:\n\n%s", body))
+		}
+
+		return fmt.Errorf("node '%s' could not be found within last response body, reason: %w", expr, err)
+	}
+
+	return nil
+}
+
+// AssertNodeNotExists checks whether last response body does not contain given node.
+// expr should be valid according to injected PathFinder for given data format
+func (apiCtx *APIContext) AssertNodeNotExists(dataFormat df.DataFormat, exprTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeNotExists", dataFormat, exprTemplate)
+
+	body, err := apiCtx.getNodeSource()
+	if err != nil {
+		return err
+	}
+
+	expr, err := apiCtx.TemplateEngine.Replace(exprTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'form' template, err: %w", err)
+	}
+
+	if body == nil || len(body) == 0 {
+		return fmt.Errorf("provided nil body")
+	}
+
+	_, err = apiCtx.getNode(body, expr, dataFormat, types.Any)
+	if err != nil {
+		return nil
+	}
+
+	if apiCtx.Debugger.IsOn() {
+		apiCtx.Debugger.Print(fmt.Sprintf("last response body:\n\n%s", body))
+	}
+
+	return &AssertionError{Expression: expr, DataFormat: dataFormat, Err: fmt.Errorf("%s node '%s' exists", dataFormat, expr)}
+}
+
+// AssertNodesExist checks whether last request body has keys defined in string separated by comma
+// nodeExprs should be valid according to injected PathFinder expressions separated by comma (,)
+func (apiCtx *APIContext) AssertNodesExist(dataFormat df.DataFormat, expressionsTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodesExist", dataFormat, expressionsTemplate)
+
+	expressions, err := apiCtx.TemplateEngine.Replace(expressionsTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'form' template, err: %w", err)
+	}
+
+	keysSlice := strings.Split(expressions, ",")
+
+	body, err := apiCtx.getNodeSource()
+	if err != nil {
+		return err
+	}
+
+	errs := make([]error, 0, len(keysSlice))
+	for _, key := range keysSlice {
+		trimmedKey := strings.TrimSpace(key)
+
+		_, err := apiCtx.getNode(body, trimmedKey, dataFormat, types.Any)
+		if err != nil {
+			var notFoundErr *NodeNotFoundError
+			if !errors.As(err, &notFoundErr) {
+				return err
+			}
+
+			errs = append(errs, fmt.Errorf("node '%s', err: %w", trimmedKey, err))
+		}
 	}
 
 	if len(errs) > 0 {
@@ -672,7 +1179,7 @@ func (apiCtx *APIContext) AssertNodesExist(dataFormat df.DataFormat, expressions
 			apiCtx.Debugger.Print(fmt.Sprintf("last response body:\n\n%s", body))
 		}
 
-		return errors.New(errString)
+		return &AssertionError{DataFormat: dataFormat, Err: errors.New(errString)}
 	}
 
 	return nil
@@ -681,15 +1188,17 @@ func (apiCtx *APIContext) AssertNodesExist(dataFormat df.DataFormat, expressions
 // AssertNodeIsType checks whether node from last response body is of provided type.
 // available types are listed in types subpackage.
 // expr should be valid according to injected PathResolver.
-func (apiCtx *APIContext) AssertNodeIsType(dataFormat df.DataFormat, exprTemplate string, inType types.DataType) error {
+func (apiCtx *APIContext) AssertNodeIsType(dataFormat df.DataFormat, exprTemplate string, inType types.DataType) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeIsType", dataFormat, exprTemplate, inType)
+
 	expr, err := apiCtx.TemplateEngine.Replace(exprTemplate, apiCtx.Cache.All())
 	if err != nil {
 		return fmt.Errorf("template engine has problem with 'form' template, err: %w", err)
 	}
 
-	body, err := apiCtx.GetLastResponseBody()
+	body, err := apiCtx.getNodeSource()
 	if err != nil {
-		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+		return err
 	}
 
 	_, err = apiCtx.getNode(body, expr, dataFormat, inType)
@@ -699,10 +1208,12 @@ func (apiCtx *APIContext) AssertNodeIsType(dataFormat df.DataFormat, exprTemplat
 // AssertNodeIsNotType checks whether node from last response body is of provided type.
 // available types are listed in types subpackage.
 // expr should be valid according to injected PathResolver.
-func (apiCtx *APIContext) AssertNodeIsNotType(dataFormat df.DataFormat, exprTemplate string, inType types.DataType) error {
-	body, err := apiCtx.GetLastResponseBody()
+func (apiCtx *APIContext) AssertNodeIsNotType(dataFormat df.DataFormat, exprTemplate string, inType types.DataType) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeIsNotType", dataFormat, exprTemplate, inType)
+
+	body, err := apiCtx.getNodeSource()
 	if err != nil {
-		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+		return err
 	}
 
 	expr, err := apiCtx.TemplateEngine.Replace(exprTemplate, apiCtx.Cache.All())
@@ -715,7 +1226,7 @@ func (apiCtx *APIContext) AssertNodeIsNotType(dataFormat df.DataFormat, exprTemp
 	case df.JSON:
 		iNodeVal, err = apiCtx.PathFinders.JSON.Find(expr, body)
 		if err != nil {
-			return fmt.Errorf("could not find node using provided expression: '%s', err: %w", expr, err)
+			return &NodeNotFoundError{Expression: expr, DataFormat: dataFormat, Err: err}
 		}
 
 		if !(inType.IsValidJSONDataType() || inType.IsValidGoDataType()) {
@@ -728,7 +1239,7 @@ func (apiCtx *APIContext) AssertNodeIsNotType(dataFormat df.DataFormat, exprTemp
 				return nil
 			}
 
-			return fmt.Errorf("node '%s' has type '%s', but expected not to be", expr, inType)
+			return &AssertionError{Expression: expr, DataFormat: dataFormat, Actual: inType, Err: fmt.Errorf("node '%s' has type '%s', but expected not to be", expr, inType)}
 		}
 
 		recognizedDataType := apiCtx.TypeMappers.GO.Map(iNodeVal)
@@ -736,11 +1247,11 @@ func (apiCtx *APIContext) AssertNodeIsNotType(dataFormat df.DataFormat, exprTemp
 			return nil
 		}
 
-		return fmt.Errorf("node '%s' has type '%s', but expected not to be", expr, inType)
+		return &AssertionError{Expression: expr, DataFormat: dataFormat, Actual: inType, Err: fmt.Errorf("node '%s' has type '%s', but expected not to be", expr, inType)}
 	case df.YAML:
 		iNodeVal, err = apiCtx.PathFinders.YAML.Find(expr, body)
 		if err != nil {
-			return fmt.Errorf("could not find node using provided expression: '%s', err: %w", expr, err)
+			return &NodeNotFoundError{Expression: expr, DataFormat: dataFormat, Err: err}
 		}
 
 		if !(inType.IsValidYAMLDataType() || inType.IsValidGoDataType()) {
@@ -753,7 +1264,7 @@ func (apiCtx *APIContext) AssertNodeIsNotType(dataFormat df.DataFormat, exprTemp
 				return nil
 			}
 
-			return fmt.Errorf("node '%s' has type '%s', but expected not to be", expr, inType)
+			return &AssertionError{Expression: expr, DataFormat: dataFormat, Actual: inType, Err: fmt.Errorf("node '%s' has type '%s', but expected not to be", expr, inType)}
 		}
 
 		recognizedDataType := apiCtx.TypeMappers.GO.Map(iNodeVal)
@@ -761,7 +1272,7 @@ func (apiCtx *APIContext) AssertNodeIsNotType(dataFormat df.DataFormat, exprTemp
 			return nil
 		}
 
-		return fmt.Errorf("node '%s' has type '%s', but expected not to be", expr, inType)
+		return &AssertionError{Expression: expr, DataFormat: dataFormat, Actual: inType, Err: fmt.Errorf("node '%s' has type '%s', but expected not to be", expr, inType)}
 	case df.XML:
 		return fmt.Errorf("this method does not support data in format: %s", df.XML)
 	default:
@@ -773,7 +1284,9 @@ func (apiCtx *APIContext) AssertNodeIsNotType(dataFormat df.DataFormat, exprTemp
 // AssertNodeIsTypeAndValue compares node value from expression to expected by user dataValue of given by user dataType
 // Available data types are listed in switch section in each case directive.
 // expr should be valid according to injected PathFinder for provided dataFormat.
-func (apiCtx *APIContext) AssertNodeIsTypeAndValue(dataFormat df.DataFormat, exprTemplate string, dataType types.DataType, dataValue string) error {
+func (apiCtx *APIContext) AssertNodeIsTypeAndValue(dataFormat df.DataFormat, exprTemplate string, dataType types.DataType, dataValue string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeIsTypeAndValue", dataFormat, exprTemplate, dataType, dataValue)
+
 	nodeValueReplaced, err := apiCtx.TemplateEngine.Replace(dataValue, apiCtx.Cache.All())
 	if err != nil {
 		return fmt.Errorf("template engine has problem with 'value' template, err: %w", err)
@@ -788,9 +1301,9 @@ func (apiCtx *APIContext) AssertNodeIsTypeAndValue(dataFormat df.DataFormat, exp
 		apiCtx.Debugger.Print(fmt.Sprintf("provided expression template '%s' was replace to '%s'", exprTemplate, expr))
 	}
 
-	body, err := apiCtx.GetLastResponseBody()
+	body, err := apiCtx.getNodeSource()
 	if err != nil {
-		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+		return err
 	}
 
 	iValue, err := apiCtx.getNode(body, expr, dataFormat, dataType)
@@ -798,12 +1311,18 @@ func (apiCtx *APIContext) AssertNodeIsTypeAndValue(dataFormat df.DataFormat, exp
 		return err
 	}
 
-	return assertNodeTypeAndValue(expr, dataType, iValue, nodeValueReplaced)
+	if err = assertNodeTypeAndValue(expr, dataType, iValue, nodeValueReplaced); err != nil {
+		return &AssertionError{Expression: expr, DataFormat: dataFormat, Expected: nodeValueReplaced, Actual: iValue, Err: err}
+	}
+
+	return nil
 }
 
 // AssertNodeIsTypeAndHasOneOfValues checks whether node value obtained using exprTemplate matches one of values held by
 // valuesTemplates argument. Values should be separated by comma (,) and may contain template values.
-func (apiCtx *APIContext) AssertNodeIsTypeAndHasOneOfValues(dataFormat df.DataFormat, exprTemplate string, dataType types.DataType, valuesTemplates string) error {
+func (apiCtx *APIContext) AssertNodeIsTypeAndHasOneOfValues(dataFormat df.DataFormat, exprTemplate string, dataType types.DataType, valuesTemplates string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeIsTypeAndHasOneOfValues", dataFormat, exprTemplate, dataType, valuesTemplates)
+
 	values, err := apiCtx.TemplateEngine.Replace(valuesTemplates, apiCtx.Cache.All())
 	if err != nil {
 		return fmt.Errorf("template engine has problem with 'valuesTemplates' template, err: %w", err)
@@ -822,9 +1341,9 @@ func (apiCtx *APIContext) AssertNodeIsTypeAndHasOneOfValues(dataFormat df.DataFo
 		apiCtx.Debugger.Print(fmt.Sprintf("provided expression template: '%s' was replace to: '%s'", exprTemplate, expr))
 	}
 
-	body, err := apiCtx.GetLastResponseBody()
+	body, err := apiCtx.getNodeSource()
 	if err != nil {
-		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+		return err
 	}
 
 	iValue, err := apiCtx.getNode(body, expr, dataFormat, dataType)
@@ -844,12 +1363,221 @@ func (apiCtx *APIContext) AssertNodeIsTypeAndHasOneOfValues(dataFormat df.DataFo
 		}
 	}
 
-	return fmt.Errorf("node '%s' doesn't contain any of: %#v", expr, valuesSliceTrimmed)
+	return &AssertionError{Expression: expr, DataFormat: dataFormat, Expected: valuesSliceTrimmed, Actual: iValue,
+		Err: fmt.Errorf("node '%s' doesn't contain any of: %#v", expr, valuesSliceTrimmed)}
+}
+
+// AssertNodeEqualsCached checks whether last HTTP(s) response body node obtained using exprTemplate is deeply equal
+// to value saved in cache under cacheKey, for example object saved earlier with SaveNode.
+// Comparison is type aware: numbers are compared by their value regardless of Go type, but number never equals string.
+// Numeric tolerance and array order insensitivity are taken from APIContext.ComparisonOptions.
+func (apiCtx *APIContext) AssertNodeEqualsCached(dataFormat df.DataFormat, exprTemplate, cacheKey string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeEqualsCached", dataFormat, exprTemplate, cacheKey)
+
+	expr, differences, err := apiCtx.compareNodeWithCachedGeneral(dataFormat, exprTemplate, cacheKey)
+	if err != nil {
+		return err
+	}
+
+	if len(differences) > 0 {
+		return &AssertionError{Expression: expr, DataFormat: dataFormat,
+			Err: fmt.Errorf("node '%s' is not equal to value saved in cache under key '%s', found %d differences:\n%s", expr, cacheKey, len(differences), comparator.FormatDifferences(differences))}
+	}
+
+	return nil
+}
+
+// AssertNodeNotEqualsCached checks whether last HTTP(s) response body node obtained using exprTemplate differs from
+// value saved in cache under cacheKey. Comparison is the same as in AssertNodeEqualsCached.
+func (apiCtx *APIContext) AssertNodeNotEqualsCached(dataFormat df.DataFormat, exprTemplate, cacheKey string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeNotEqualsCached", dataFormat, exprTemplate, cacheKey)
+
+	expr, differences, err := apiCtx.compareNodeWithCachedGeneral(dataFormat, exprTemplate, cacheKey)
+	if err != nil {
+		return err
+	}
+
+	if len(differences) == 0 {
+		return &AssertionError{Expression: expr, DataFormat: dataFormat,
+			Err: fmt.Errorf("node '%s' is equal to value saved in cache under key '%s', but expected not to be", expr, cacheKey)}
+	}
+
+	return nil
+}
+
+// AssertNodeNumberCompare checks whether number from last HTTP(s) response body node obtained using exprTemplate
+// satisfies operator with numbers from valueTemplate. Available operators are listed in mathutils package:
+// >, >=, <, <= accept single number, "between" accepts comma separated lower and upper bound, for example: "10, 20"
+// and "approx" accepts comma separated expected number and allowed delta, for example: "9.99, 0.01".
+// XML and HTML nodes are accepted as long as their text parses as number.
+func (apiCtx *APIContext) AssertNodeNumberCompare(dataFormat df.DataFormat, exprTemplate string, operator mathutils.Operator, valueTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeNumberCompare", dataFormat, exprTemplate, operator, valueTemplate)
+
+	expr, err := apiCtx.TemplateEngine.Replace(exprTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'expression' template, err: %w", err)
+	}
+
+	value, err := apiCtx.TemplateEngine.Replace(valueTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'value' template, err: %w", err)
+	}
+
+	if apiCtx.Debugger.IsOn() {
+		apiCtx.Debugger.Print(fmt.Sprintf("provided value template: '%s' was replace to: '%s'", valueTemplate, value))
+	}
+
+	args := make([]float64, 0, 2)
+	for _, arg := range splitList(value) {
+		number, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return fmt.Errorf("value '%s' could not be converted to float64, err: %w", arg, err)
+		}
+
+		args = append(args, number)
+	}
+
+	body, err := apiCtx.getNodeSource()
+	if err != nil {
+		return err
+	}
+
+	iValue, err := apiCtx.getNode(body, expr, dataFormat, types.Any)
+	if err != nil {
+		return err
+	}
+
+	number, err := nodeToFloat64(iValue, dataFormat, expr)
+	if err != nil {
+		return &AssertionError{Expression: expr, DataFormat: dataFormat, Actual: iValue, Err: err}
+	}
+
+	satisfied, err := mathutils.Compare(number, operator, args...)
+	if err != nil {
+		return fmt.Errorf("could not compare node '%s' value, err: %w", expr, err)
+	}
+
+	if !satisfied {
+		return &AssertionError{Expression: expr, DataFormat: dataFormat, Expected: fmt.Sprintf("%s %s", operator, value), Actual: number,
+			Err: fmt.Errorf("node '%s' has value %v, which does not satisfy condition: %s %s", expr, number, operator, value)}
+	}
+
+	return nil
+}
+
+// AssertNodeTimeIsBefore checks whether time from last HTTP(s) response body node obtained using exprTemplate
+// is before time saved in cache under cacheKey, for example by GenerateTimeAndTravel method.
+// layout may be any layout accepted by time.Parse, "unix" or "unixmilli", empty layout means RFC3339.
+func (apiCtx *APIContext) AssertNodeTimeIsBefore(dataFormat df.DataFormat, exprTemplate, layout, cacheKey string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeTimeIsBefore", dataFormat, exprTemplate, layout, cacheKey)
+
+	nodeTime, expr, err := apiCtx.getNodeTime(dataFormat, exprTemplate, layout)
+	if err != nil {
+		return err
+	}
+
+	cachedTime, err := apiCtx.getCachedTime(cacheKey)
+	if err != nil {
+		return err
+	}
+
+	if !nodeTime.Before(cachedTime) {
+		return &AssertionError{Expression: expr, DataFormat: dataFormat, Expected: cachedTime, Actual: nodeTime,
+			Err: fmt.Errorf("node '%s' has time %s, which is not before %s", expr, nodeTime.Format(time.RFC3339Nano), cachedTime.Format(time.RFC3339Nano))}
+	}
+
+	return nil
+}
+
+// AssertNodeTimeIsAfter checks whether time from last HTTP(s) response body node obtained using exprTemplate
+// is after time saved in cache under cacheKey, for example by GenerateTimeAndTravel method.
+// layout may be any layout accepted by time.Parse, "unix" or "unixmilli", empty layout means RFC3339.
+func (apiCtx *APIContext) AssertNodeTimeIsAfter(dataFormat df.DataFormat, exprTemplate, layout, cacheKey string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeTimeIsAfter", dataFormat, exprTemplate, layout, cacheKey)
+
+	nodeTime, expr, err := apiCtx.getNodeTime(dataFormat, exprTemplate, layout)
+	if err != nil {
+		return err
+	}
+
+	cachedTime, err := apiCtx.getCachedTime(cacheKey)
+	if err != nil {
+		return err
+	}
+
+	if !nodeTime.After(cachedTime) {
+		return &AssertionError{Expression: expr, DataFormat: dataFormat, Expected: cachedTime, Actual: nodeTime,
+			Err: fmt.Errorf("node '%s' has time %s, which is not after %s", expr, nodeTime.Format(time.RFC3339Nano), cachedTime.Format(time.RFC3339Nano))}
+	}
+
+	return nil
+}
+
+// AssertNodeTimeIsWithin checks whether time from last HTTP(s) response body node obtained using exprTemplate
+// differs from current time by no more than timeInterval, in either direction.
+// layout may be any layout accepted by time.Parse, "unix" or "unixmilli", empty layout means RFC3339.
+func (apiCtx *APIContext) AssertNodeTimeIsWithin(dataFormat df.DataFormat, exprTemplate, layout string, timeInterval time.Duration) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeTimeIsWithin", dataFormat, exprTemplate, layout, timeInterval)
+
+	nodeTime, expr, err := apiCtx.getNodeTime(dataFormat, exprTemplate, layout)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	difference := now.Sub(nodeTime)
+	if difference < 0 {
+		difference = -difference
+	}
+
+	if difference > timeInterval {
+		return &AssertionError{Expression: expr, DataFormat: dataFormat, Expected: timeInterval, Actual: difference,
+			Err: fmt.Errorf("node '%s' has time %s, which differs from current time %s by %s, expected at most %s",
+				expr, nodeTime.Format(time.RFC3339Nano), now.Format(time.RFC3339Nano), difference, timeInterval)}
+	}
+
+	return nil
+}
+
+// AssertNodeTimeIsInTimezone checks whether time from last HTTP(s) response body node obtained using exprTemplate
+// is written in given timezone. timezoneTemplate may be IANA time zone name, for example: "Europe/Warsaw", "UTC"
+// or UTC offset, for example: "+02:00".
+// layout may be any layout accepted by time.Parse, empty layout means RFC3339.
+func (apiCtx *APIContext) AssertNodeTimeIsInTimezone(dataFormat df.DataFormat, exprTemplate, layout, timezoneTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeTimeIsInTimezone", dataFormat, exprTemplate, layout, timezoneTemplate)
+
+	timezone, err := apiCtx.TemplateEngine.Replace(timezoneTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'timezone' template, err: %w", err)
+	}
+
+	if layout == timeutils.LayoutUnix || layout == timeutils.LayoutUnixMilli {
+		return fmt.Errorf("time written in layout '%s' does not carry information about timezone", layout)
+	}
+
+	nodeTime, expr, err := apiCtx.getNodeTime(dataFormat, exprTemplate, layout)
+	if err != nil {
+		return err
+	}
+
+	isInTimezone, err := timeutils.IsInTimezone(nodeTime, timezone)
+	if err != nil {
+		return err
+	}
+
+	if !isInTimezone {
+		return &AssertionError{Expression: expr, DataFormat: dataFormat, Expected: timezone, Actual: nodeTime,
+			Err: fmt.Errorf("node '%s' has time %s, which is not in timezone %s", expr, nodeTime.Format(time.RFC3339Nano), timezone)}
+	}
+
+	return nil
 }
 
 // AssertNodeContainsSubString AsserNodeContainsSubString checks whether value of last HTTP response node, obtained using exprTemplate
 // is string type and contains given substring
-func (apiCtx *APIContext) AssertNodeContainsSubString(dataFormat df.DataFormat, exprTemplate string, subTemplate string) error {
+func (apiCtx *APIContext) AssertNodeContainsSubString(dataFormat df.DataFormat, exprTemplate string, subTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeContainsSubString", dataFormat, exprTemplate, subTemplate)
+
 	expr, err := apiCtx.TemplateEngine.Replace(exprTemplate, apiCtx.Cache.All())
 	if err != nil {
 		return fmt.Errorf("template engine has problem with 'expr' template, err: %w", err)
@@ -868,9 +1596,9 @@ func (apiCtx *APIContext) AssertNodeContainsSubString(dataFormat df.DataFormat,
 		apiCtx.Debugger.Print(fmt.Sprintf("provided substring template: '%s' was replace to: '%s'", subTemplate, sub))
 	}
 
-	body, err := apiCtx.GetLastResponseBody()
+	body, err := apiCtx.getNodeSource()
 	if err != nil {
-		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+		return err
 	}
 
 	iValue, err := apiCtx.getNode(body, expr, dataFormat, types.String)
@@ -884,7 +1612,8 @@ func (apiCtx *APIContext) AssertNodeContainsSubString(dataFormat df.DataFormat,
 	}
 
 	if !strings.Contains(valueString, sub) {
-		return fmt.Errorf("node '%s' string value doesn't contain any occurrence of '%s'", expr, sub)
+		return &AssertionError{Expression: expr, DataFormat: dataFormat, Expected: sub, Actual: valueString,
+			Err: fmt.Errorf("node '%s' string value doesn't contain any occurrence of '%s'", expr, sub)}
 	}
 
 	return nil
@@ -892,7 +1621,9 @@ func (apiCtx *APIContext) AssertNodeContainsSubString(dataFormat df.DataFormat,
 
 // AssertNodeNotContainsSubString AsserNodeNotContainsSubString checks whether value of last HTTP response node, obtained using exprTemplate
 // is string type and doesn't contain given substring
-func (apiCtx *APIContext) AssertNodeNotContainsSubString(dataFormat df.DataFormat, exprTemplate string, subTemplate string) error {
+func (apiCtx *APIContext) AssertNodeNotContainsSubString(dataFormat df.DataFormat, exprTemplate string, subTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeNotContainsSubString", dataFormat, exprTemplate, subTemplate)
+
 	expr, err := apiCtx.TemplateEngine.Replace(exprTemplate, apiCtx.Cache.All())
 	if err != nil {
 		return fmt.Errorf("template engine has problem with 'expr' template, err: %w", err)
@@ -911,9 +1642,9 @@ func (apiCtx *APIContext) AssertNodeNotContainsSubString(dataFormat df.DataForma
 		apiCtx.Debugger.Print(fmt.Sprintf("provided substring template: '%s' was replace to: '%s'", subTemplate, sub))
 	}
 
-	body, err := apiCtx.GetLastResponseBody()
+	body, err := apiCtx.getNodeSource()
 	if err != nil {
-		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+		return err
 	}
 
 	iValue, err := apiCtx.getNode(body, expr, dataFormat, types.String)
@@ -927,7 +1658,8 @@ func (apiCtx *APIContext) AssertNodeNotContainsSubString(dataFormat df.DataForma
 	}
 
 	if strings.Contains(valueString, sub) {
-		return fmt.Errorf("node '%s' string value contain some '%s', but expected not to", expr, sub)
+		return &AssertionError{Expression: expr, DataFormat: dataFormat, Actual: valueString,
+			Err: fmt.Errorf("node '%s' string value contain some '%s', but expected not to", expr, sub)}
 	}
 
 	return nil
@@ -935,10 +1667,12 @@ func (apiCtx *APIContext) AssertNodeNotContainsSubString(dataFormat df.DataForma
 
 // AssertNodeSliceLengthIs checks whether given key is slice and has given length
 // expr should be valid according to injected PathFinder for provided dataFormat
-func (apiCtx *APIContext) AssertNodeSliceLengthIs(dataFormat df.DataFormat, exprTemplate string, length int) error {
-	body, err := apiCtx.GetLastResponseBody()
+func (apiCtx *APIContext) AssertNodeSliceLengthIs(dataFormat df.DataFormat, exprTemplate string, length int) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeSliceLengthIs", dataFormat, exprTemplate, length)
+
+	body, err := apiCtx.getNodeSource()
 	if err != nil {
-		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+		return err
 	}
 
 	expr, err := apiCtx.TemplateEngine.Replace(exprTemplate, apiCtx.Cache.All())
@@ -952,13 +1686,14 @@ func (apiCtx *APIContext) AssertNodeSliceLengthIs(dataFormat df.DataFormat, expr
 			apiCtx.Debugger.Print(fmt.Sprintf("last response body:\n\n%s", body))
 		}
 
-		return fmt.Errorf("node '%s', err: %s", expr, err.Error())
+		return fmt.Errorf("node '%s', err: %w", expr, err)
 	}
 
 	v := reflect.ValueOf(iValue)
 	if v.Kind() == reflect.Slice {
 		if v.Len() != length {
-			return fmt.Errorf("node '%s' contains slice(array) which has length: %d, but expected: %d", expr, v.Len(), length)
+			return &AssertionError{Expression: expr, DataFormat: dataFormat, Expected: length, Actual: v.Len(),
+				Err: fmt.Errorf("node '%s' contains slice(array) which has length: %d, but expected: %d", expr, v.Len(), length)}
 		}
 
 		return nil
@@ -968,15 +1703,18 @@ func (apiCtx *APIContext) AssertNodeSliceLengthIs(dataFormat df.DataFormat, expr
 		apiCtx.Debugger.Print(fmt.Sprintf("last response body:\n\n%s", body))
 	}
 
-	return fmt.Errorf("%s does not point at slice(array) in last HTTP(s) response body", expr)
+	return &AssertionError{Expression: expr, DataFormat: dataFormat,
+		Err: fmt.Errorf("%s does not point at slice(array) in last HTTP(s) response body", expr)}
 }
 
 // AssertNodeSliceLengthIsNot checks whether given key is slice and has not given length
 // expr should be valid according to injected PathFinder for provided dataFormat
-func (apiCtx *APIContext) AssertNodeSliceLengthIsNot(dataFormat df.DataFormat, exprTemplate string, length int) error {
-	body, err := apiCtx.GetLastResponseBody()
+func (apiCtx *APIContext) AssertNodeSliceLengthIsNot(dataFormat df.DataFormat, exprTemplate string, length int) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeSliceLengthIsNot", dataFormat, exprTemplate, length)
+
+	body, err := apiCtx.getNodeSource()
 	if err != nil {
-		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+		return err
 	}
 
 	expr, err := apiCtx.TemplateEngine.Replace(exprTemplate, apiCtx.Cache.All())
@@ -990,7 +1728,7 @@ func (apiCtx *APIContext) AssertNodeSliceLengthIsNot(dataFormat df.DataFormat, e
 			apiCtx.Debugger.Print(fmt.Sprintf("last response body:\n\n%s", body))
 		}
 
-		return fmt.Errorf("node '%s', err: %s", expr, err.Error())
+		return fmt.Errorf("node '%s', err: %w", expr, err)
 	}
 
 	v := reflect.ValueOf(iValue)
@@ -999,18 +1737,225 @@ func (apiCtx *APIContext) AssertNodeSliceLengthIsNot(dataFormat df.DataFormat, e
 			return nil
 		}
 
-		return fmt.Errorf("node '%s' contains slice(array) which has length: %d, but expected not to have it", expr, v.Len())
+		return &AssertionError{Expression: expr, DataFormat: dataFormat, Actual: v.Len(),
+			Err: fmt.Errorf("node '%s' contains slice(array) which has length: %d, but expected not to have it", expr, v.Len())}
 	}
 
 	if apiCtx.Debugger.IsOn() {
 		apiCtx.Debugger.Print(fmt.Sprintf("last response body:\n\n%s", body))
 	}
 
-	return fmt.Errorf("%s does not point at slice(array) in last HTTP(s) response body", expr)
+	return &AssertionError{Expression: expr, DataFormat: dataFormat,
+		Err: fmt.Errorf("%s does not point at slice(array) in last HTTP(s) response body", expr)}
+}
+
+// AssertNodeElementsHaveValue checks whether every, any or none (depending on quantifier) element of array node
+// obtained using exprTemplate has node obtained using subExprTemplate equal to valueTemplate.
+// subExprTemplate is evaluated against each element separately, empty subExprTemplate means element itself.
+// Available data formats are JSON and YAML. Failure reports indices of offending elements.
+func (apiCtx *APIContext) AssertNodeElementsHaveValue(dataFormat df.DataFormat, exprTemplate string, quantifier collection.Quantifier, subExprTemplate, valueTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeElementsHaveValue", dataFormat, exprTemplate, quantifier, subExprTemplate, valueTemplate)
+
+	value, err := apiCtx.TemplateEngine.Replace(valueTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'value' template, err: %w", err)
+	}
+
+	return apiCtx.assertNodeElementsGeneral(dataFormat, exprTemplate, quantifier, subExprTemplate, fmt.Sprintf("is equal to '%s'", value), func(node any) bool {
+		return apiCtx.nodeValueToString(node) == value
+	})
+}
+
+// AssertNodeElementsMatchRegExp checks whether every, any or none (depending on quantifier) element of array node
+// obtained using exprTemplate has node obtained using subExprTemplate matching regExpTemplate.
+// subExprTemplate is evaluated against each element separately, empty subExprTemplate means element itself.
+// Available data formats are JSON and YAML. Failure reports indices of offending elements.
+func (apiCtx *APIContext) AssertNodeElementsMatchRegExp(dataFormat df.DataFormat, exprTemplate string, quantifier collection.Quantifier, subExprTemplate, regExpTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeElementsMatchRegExp", dataFormat, exprTemplate, quantifier, subExprTemplate, regExpTemplate)
+
+	regExpString, err := apiCtx.TemplateEngine.Replace(regExpTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'regExp' template, err: %w", err)
+	}
+
+	regExp, err := regexp.Compile(regExpString)
+	if err != nil {
+		return fmt.Errorf("could not compile regExp '%s', err: %w", regExpString, err)
+	}
+
+	return apiCtx.assertNodeElementsGeneral(dataFormat, exprTemplate, quantifier, subExprTemplate, fmt.Sprintf("matches regExp '%s'", regExpString), func(node any) bool {
+		return regExp.MatchString(apiCtx.nodeValueToString(node))
+	})
+}
+
+// AssertNodeElementsAreType checks whether every, any or none (depending on quantifier) element of array node
+// obtained using exprTemplate has node obtained using subExprTemplate of given dataType.
+// subExprTemplate is evaluated against each element separately, empty subExprTemplate means element itself.
+// Available data formats are JSON and YAML. Failure reports indices of offending elements.
+func (apiCtx *APIContext) AssertNodeElementsAreType(dataFormat df.DataFormat, exprTemplate string, quantifier collection.Quantifier, subExprTemplate string, dataType types.DataType) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeElementsAreType", dataFormat, exprTemplate, quantifier, subExprTemplate, dataType)
+
+	return apiCtx.assertNodeElementsGeneral(dataFormat, exprTemplate, quantifier, subExprTemplate, fmt.Sprintf("is of type '%s'", dataType), func(node any) bool {
+		return apiCtx.isNodeOfType(node, dataFormat, dataType)
+	})
+}
+
+// AssertNodeElementsAreSorted checks whether elements of array node obtained using exprTemplate are sorted in given order
+// by node obtained from each element using subExprTemplate, empty subExprTemplate means element itself.
+// comparison tells whether nodes are compared as strings, numbers or times, layout is used only to parse times
+// and accepts the same values as in AssertNodeTimeIsBefore. Available data formats are JSON and YAML.
+func (apiCtx *APIContext) AssertNodeElementsAreSorted(dataFormat df.DataFormat, exprTemplate, subExprTemplate string, order collection.Order, comparison collection.Comparison, layout string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeElementsAreSorted", dataFormat, exprTemplate, subExprTemplate, order, comparison, layout)
+
+	expr, subExpr, elements, err := apiCtx.getNodeSubElements(dataFormat, exprTemplate, subExprTemplate)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]any, len(elements))
+	for i, element := range elements {
+		switch comparison {
+		case collection.ComparisonString:
+			keys[i] = apiCtx.nodeValueToString(element)
+		case collection.ComparisonNumber:
+			keys[i], err = nodeToFloat64(element, dataFormat, fmt.Sprintf("%s[%d]", expr, i))
+		case collection.ComparisonTime:
+			keys[i], err = parseNodeTime(element, dataFormat, fmt.Sprintf("%s[%d]", expr, i), layout)
+		default:
+			return fmt.Errorf("unknown comparison: %s, allowed: %s, %s, %s", comparison, collection.ComparisonString, collection.ComparisonNumber, collection.ComparisonTime)
+		}
+
+		if err != nil {
+			return &AssertionError{Expression: fmt.Sprintf("%s[%d]", expr, i), DataFormat: dataFormat, Actual: element, Err: err}
+		}
+	}
+
+	index, err := collection.FirstUnsorted(keys, order)
+	if err != nil {
+		return err
+	}
+
+	if index >= 0 {
+		return &AssertionError{Expression: expr, DataFormat: dataFormat,
+			Err: fmt.Errorf("elements of node '%s' are not sorted in %s order by %s, element %d with value '%v' is out of order after element %d with value '%v'",
+				expr, order, elementSubject(subExpr), index, elements[index], index-1, elements[index-1])}
+	}
+
+	return nil
+}
+
+// AssertNodeElementsAreUnique checks whether elements of array node obtained using exprTemplate have unique values
+// of node obtained from each element using subExprTemplate, empty subExprTemplate means element itself.
+// Available data formats are JSON and YAML. Failure reports indices of elements with duplicated values.
+func (apiCtx *APIContext) AssertNodeElementsAreUnique(dataFormat df.DataFormat, exprTemplate, subExprTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeElementsAreUnique", dataFormat, exprTemplate, subExprTemplate)
+
+	expr, subExpr, elements, err := apiCtx.getNodeSubElements(dataFormat, exprTemplate, subExprTemplate)
+	if err != nil {
+		return err
+	}
+
+	// keys are JSON representations of normalized values, so values of different types, for example "1" and 1, differ
+	keys := make([]string, len(elements))
+	for i, element := range elements {
+		normalized, err := comparator.Normalize(element)
+		if err != nil {
+			return fmt.Errorf("element %d of node '%s', err: %w", i, expr, err)
+		}
+
+		key, err := json.Marshal(normalized)
+		if err != nil {
+			return fmt.Errorf("could not serialize element %d of node '%s', err: %w", i, expr, err)
+		}
+
+		keys[i] = string(key)
+	}
+
+	duplicates := collection.Duplicates(keys)
+	if len(duplicates) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, 0, len(duplicates))
+	for _, indices := range duplicates {
+		descriptions = append(descriptions, fmt.Sprintf("'%s' at indices %v", keys[indices[0]], indices))
+	}
+
+	return &AssertionError{Expression: expr, DataFormat: dataFormat,
+		Err: fmt.Errorf("elements of node '%s' have duplicated values of %s: %s", expr, elementSubject(subExpr), strings.Join(descriptions, ", "))}
+}
+
+// AssertNodeHasSameElementsAs checks whether array node obtained using exprTemplate contains the same elements
+// as array provided in expectedTemplate, regardless of their order. Repeated elements are counted.
+// expectedTemplate should be array in provided dataFormat, for example: [1, 2, 3]. Available data formats are JSON and YAML.
+func (apiCtx *APIContext) AssertNodeHasSameElementsAs(dataFormat df.DataFormat, exprTemplate, expectedTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeHasSameElementsAs", dataFormat, exprTemplate, expectedTemplate)
+
+	expected, err := apiCtx.TemplateEngine.Replace(expectedTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'expected' template, err: %w", err)
+	}
+
+	expr, err := apiCtx.TemplateEngine.Replace(exprTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'expression' template, err: %w", err)
+	}
+
+	body, err := apiCtx.getNodeSource()
+	if err != nil {
+		return err
+	}
+
+	elements, err := apiCtx.getNodeElements(body, expr, dataFormat)
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	if dataFormat == df.JSON {
+		data, err = apiCtx.Serializers.JSON.Serialize(elements)
+	} else {
+		data, err = apiCtx.Serializers.YAML.Serialize(elements)
+	}
+
+	if err != nil {
+		return fmt.Errorf("problem during formatting node '%s' to %s, err: %w", expr, dataFormat, err)
+	}
+
+	actualDocument, err := comparator.Decode(data, dataFormat)
+	if err != nil {
+		return err
+	}
+
+	expectedDocument, err := comparator.Decode([]byte(expected), dataFormat)
+	if err != nil {
+		return fmt.Errorf("could not decode expected array, err: %w", err)
+	}
+
+	if _, ok := expectedDocument.([]any); !ok {
+		return fmt.Errorf("expected value should be array, got: %s", expected)
+	}
+
+	options := apiCtx.ComparisonOptions
+	options.IgnoreArrayOrder = true
+
+	differences, err := comparator.Compare(expectedDocument, actualDocument, options)
+	if err != nil {
+		return err
+	}
+
+	if len(differences) > 0 {
+		return &AssertionError{Expression: expr, DataFormat: dataFormat,
+			Err: fmt.Errorf("node '%s' does not have the same elements as expected array, found %d differences:\n%s", expr, len(differences), comparator.FormatDifferences(differences))}
+	}
+
+	return nil
 }
 
 // AssertNodeMatchesRegExp checks whether last response body node matches provided regExp.
-func (apiCtx *APIContext) AssertNodeMatchesRegExp(dataFormat df.DataFormat, exprTemplate, regExpTemplate string) error {
+func (apiCtx *APIContext) AssertNodeMatchesRegExp(dataFormat df.DataFormat, exprTemplate, regExpTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeMatchesRegExp", dataFormat, exprTemplate, regExpTemplate)
+
 	regExpString, err := apiCtx.TemplateEngine.Replace(regExpTemplate, apiCtx.Cache.All())
 	if err != nil {
 		return fmt.Errorf("template engine has problem with 'regExp' template, err: %w", err)
@@ -1021,14 +1966,14 @@ func (apiCtx *APIContext) AssertNodeMatchesRegExp(dataFormat df.DataFormat, expr
 		return fmt.Errorf("template engine has problem with 'expression' template, err: %w", err)
 	}
 
-	body, err := apiCtx.GetLastResponseBody()
+	body, err := apiCtx.getNodeSource()
 	if err != nil {
-		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+		return err
 	}
 
 	iValue, err := apiCtx.getNode(body, expr, dataFormat, types.Any)
 	if err != nil {
-		return fmt.Errorf("node '%s', err: %s", expr, err.Error())
+		return fmt.Errorf("node '%s', err: %w", expr, err)
 	}
 
 	jsonValue, err := apiCtx.Serializers.JSON.Serialize(iValue)
@@ -1046,14 +1991,17 @@ func (apiCtx *APIContext) AssertNodeMatchesRegExp(dataFormat df.DataFormat, expr
 	}
 
 	if !matched {
-		return fmt.Errorf("node '%s' does not match regExp: '%s'", expr, regExpString)
+		return &AssertionError{Expression: expr, DataFormat: dataFormat, Expected: regExpString, Actual: iValue,
+			Err: fmt.Errorf("node '%s' does not match regExp: '%s'", expr, regExpString)}
 	}
 
 	return nil
 }
 
 // AssertNodeNotMatchesRegExp checks whether last response body node does not match provided regExp.
-func (apiCtx *APIContext) AssertNodeNotMatchesRegExp(dataFormat df.DataFormat, exprTemplate, regExpTemplate string) error {
+func (apiCtx *APIContext) AssertNodeNotMatchesRegExp(dataFormat df.DataFormat, exprTemplate, regExpTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeNotMatchesRegExp", dataFormat, exprTemplate, regExpTemplate)
+
 	regExpString, err := apiCtx.TemplateEngine.Replace(regExpTemplate, apiCtx.Cache.All())
 	if err != nil {
 		return fmt.Errorf("template engine has problem with 'regExp' template, err: %w", err)
@@ -1064,14 +2012,14 @@ func (apiCtx *APIContext) AssertNodeNotMatchesRegExp(dataFormat df.DataFormat, e
 		return fmt.Errorf("template engine has problem with 'expression' template, err: %w", err)
 	}
 
-	body, err := apiCtx.GetLastResponseBody()
+	body, err := apiCtx.getNodeSource()
 	if err != nil {
-		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+		return err
 	}
 
 	iValue, err := apiCtx.getNode(body, expr, dataFormat, types.Any)
 	if err != nil {
-		return fmt.Errorf("node '%s', err: %s", expr, err.Error())
+		return fmt.Errorf("node '%s', err: %w", expr, err)
 	}
 
 	jsonValue, err := apiCtx.Serializers.JSON.Serialize(iValue)
@@ -1092,11 +2040,14 @@ func (apiCtx *APIContext) AssertNodeNotMatchesRegExp(dataFormat df.DataFormat, e
 		return nil
 	}
 
-	return fmt.Errorf("node '%s' matches regExp: '%s', but expected not to", expr, regExpString)
+	return &AssertionError{Expression: expr, DataFormat: dataFormat, Actual: iValue,
+		Err: fmt.Errorf("node '%s' matches regExp: '%s', but expected not to", expr, regExpString)}
 }
 
 // AssertResponseHeaderExists checks whether last HTTP response has given header.
-func (apiCtx *APIContext) AssertResponseHeaderExists(name string) error {
+func (apiCtx *APIContext) AssertResponseHeaderExists(name string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseHeaderExists", name)
+
 	defer func() {
 		if apiCtx.Debugger.IsOn() {
 			lastResp, err := apiCtx.GetLastResponse()
@@ -1122,11 +2073,13 @@ func (apiCtx *APIContext) AssertResponseHeaderExists(name string) error {
 		apiCtx.Debugger.Print(fmt.Sprintf("last HTTP(s) response headers: %#v", lastResp.Header))
 	}
 
-	return fmt.Errorf("could not find header '%s' in last HTTP response", name)
+	return &AssertionError{Expression: name, Err: fmt.Errorf("could not find header '%s' in last HTTP response", name)}
 }
 
 // AssertResponseHeaderNotExists checks whether last HTTP response does not have given header.
-func (apiCtx *APIContext) AssertResponseHeaderNotExists(name string) error {
+func (apiCtx *APIContext) AssertResponseHeaderNotExists(name string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseHeaderNotExists", name)
+
 	defer func() {
 		if apiCtx.Debugger.IsOn() {
 			lastResp, err := apiCtx.GetLastResponse()
@@ -1148,11 +2101,13 @@ func (apiCtx *APIContext) AssertResponseHeaderNotExists(name string) error {
 		return nil
 	}
 
-	return fmt.Errorf("last HTTP(s) response has header '%s', but expected not to", name)
+	return &AssertionError{Expression: name, Err: fmt.Errorf("last HTTP(s) response has header '%s', but expected not to", name)}
 }
 
 // AssertResponseHeaderValueIs checks whether last HTTP response has given header with provided valueTemplate.
-func (apiCtx *APIContext) AssertResponseHeaderValueIs(name, valueTemplate string) error {
+func (apiCtx *APIContext) AssertResponseHeaderValueIs(name, valueTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseHeaderValueIs", name, valueTemplate)
+
 	defer func() {
 		if apiCtx.Debugger.IsOn() {
 			lastResp, err := apiCtx.GetLastResponse()
@@ -1176,511 +2131,2710 @@ func (apiCtx *APIContext) AssertResponseHeaderValueIs(name, valueTemplate string
 	}
 
 	if header == "" || value == "" {
-		return fmt.Errorf("could not find header %s in last HTTP(s) response", name)
+		return &AssertionError{Expression: name, Expected: value, Actual: header,
+			Err: fmt.Errorf("could not find header %s in last HTTP(s) response", name)}
 	}
 
 	if header == value {
 		return nil
 	}
 
-	return fmt.Errorf("last HTTP(s) response contains header '%s', but it's expected value: '%s', is not equal to actual value: '%s'", name, value, header)
+	return &AssertionError{Expression: name, Expected: value, Actual: header,
+		Err: fmt.Errorf("last HTTP(s) response contains header '%s', but it's expected value: '%s', is not equal to actual value: '%s'", name, value, header)}
 }
 
-// AssertResponseMatchesSchemaByReference validates last response body against schema as provided in referenceTemplate.
-// referenceTemplate may be: URL or full/relative path
-func (apiCtx *APIContext) AssertResponseMatchesSchemaByReference(referenceTemplate string) error {
-	reference, err := apiCtx.TemplateEngine.Replace(referenceTemplate, apiCtx.Cache.All())
+// AssertResponseHeaderValueMatchesRegExp checks whether last HTTP response has given header with value matching regExpTemplate.
+// When header is repeated, it is enough that one of its values matches.
+func (apiCtx *APIContext) AssertResponseHeaderValueMatchesRegExp(name, regExpTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseHeaderValueMatchesRegExp", name, regExpTemplate)
+
+	regExpString, err := apiCtx.TemplateEngine.Replace(regExpTemplate, apiCtx.Cache.All())
 	if err != nil {
-		return fmt.Errorf("template engine has problem with 'reference' template, err: %w", err)
+		return fmt.Errorf("template engine has problem with 'regExp' template, err: %w", err)
 	}
 
-	body, err := apiCtx.GetLastResponseBody()
+	regExp, err := regexp.Compile(regExpString)
 	if err != nil {
-		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+		return fmt.Errorf("could not compile regExp '%s', err: %w", regExpString, err)
 	}
 
-	if apiCtx.Debugger.IsOn() {
-		apiCtx.Debugger.Print(fmt.Sprintf("'%s' was replaced as: '%s'\n", referenceTemplate, reference))
+	values, err := apiCtx.getLastResponseHeaderValues(name)
+	if err != nil {
+		return err
 	}
 
-	return apiCtx.SchemaValidators.ReferenceValidator.Validate(string(body), reference)
+	for _, value := range values {
+		if regExp.MatchString(value) {
+			return nil
+		}
+	}
+
+	return &AssertionError{Expression: name, Expected: regExpString, Actual: values,
+		Err: fmt.Errorf("last HTTP(s) response header '%s' values %q do not match regExp: '%s'", name, values, regExpString)}
 }
 
-// AssertResponseMatchesSchemaByString validates last response body against schema.
-func (apiCtx *APIContext) AssertResponseMatchesSchemaByString(schema string) error {
-	body, err := apiCtx.GetLastResponseBody()
+// AssertResponseHeaderValueContains checks whether last HTTP response has given header with value containing subTemplate.
+// When header is repeated, it is enough that one of its values contains it.
+func (apiCtx *APIContext) AssertResponseHeaderValueContains(name, subTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseHeaderValueContains", name, subTemplate)
+
+	sub, err := apiCtx.TemplateEngine.Replace(subTemplate, apiCtx.Cache.All())
 	if err != nil {
-		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+		return fmt.Errorf("template engine has problem with 'sub' template, err: %w", err)
 	}
 
-	return apiCtx.SchemaValidators.StringValidator.Validate(string(body), schema)
-}
+	values, err := apiCtx.getLastResponseHeaderValues(name)
+	if err != nil {
+		return err
+	}
 
-// AssertNodeMatchesSchemaByString validates last response body JSON node against schema
-func (apiCtx *APIContext) AssertNodeMatchesSchemaByString(dataFormat df.DataFormat, exprTemplate, schemaTemplate string) error {
-	return apiCtx.iValidateNodeWithSchemaGeneral(dataFormat, exprTemplate, schemaTemplate, apiCtx.SchemaValidators.StringValidator)
-}
+	for _, value := range values {
+		if strings.Contains(value, sub) {
+			return nil
+		}
+	}
 
-// AssertNodeMatchesSchemaByReference validates last response body node against schema as provided in referenceTemplate
-func (apiCtx *APIContext) AssertNodeMatchesSchemaByReference(dataFormat df.DataFormat, exprTemplate, referenceTemplate string) error {
-	return apiCtx.iValidateNodeWithSchemaGeneral(dataFormat, exprTemplate, referenceTemplate, apiCtx.SchemaValidators.ReferenceValidator)
+	return &AssertionError{Expression: name, Expected: sub, Actual: values,
+		Err: fmt.Errorf("last HTTP(s) response header '%s' values %q do not contain: '%s'", name, values, sub)}
 }
 
-// AssertTimeBetweenRequestAndResponseIs asserts that last HTTP request-response time
-// is <= than expected timeInterval.
-// timeInterval should be string acceptable by time.ParseDuration func
-func (apiCtx *APIContext) AssertTimeBetweenRequestAndResponseIs(timeInterval time.Duration) error {
-	lastReqTimestampI, err := apiCtx.Cache.GetSaved(httpcache.LastHTTPRequestTimestamp)
+// AssertResponseHeaderHasAllValues checks whether last HTTP response has given header with every value from comma
+// separated valuesTemplate, for example: "Accept, Origin". Values of repeated header and comma separated values
+// of single header are taken into account, so both "Vary: Accept, Origin" and two "Vary" headers are accepted.
+func (apiCtx *APIContext) AssertResponseHeaderHasAllValues(name, valuesTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseHeaderHasAllValues", name, valuesTemplate)
+
+	expectedValues, err := apiCtx.TemplateEngine.Replace(valuesTemplate, apiCtx.Cache.All())
 	if err != nil {
-		return fmt.Errorf("problem during obtaining last HTTP request timestamp, err: %w", err)
+		return fmt.Errorf("template engine has problem with 'values' template, err: %w", err)
 	}
 
-	lastReqTimestamp, ok := lastReqTimestampI.(time.Time)
-	if !ok {
-		return fmt.Errorf("last request timestamp: '%+v' should be time.Time", lastReqTimestampI)
+	values, err := apiCtx.getLastResponseHeaderValues(name)
+	if err != nil {
+		return err
 	}
 
-	lastResTimestampI, err := apiCtx.Cache.GetSaved(httpcache.LastHTTPResponseTimestamp)
-	if err != nil {
-		return fmt.Errorf("problem during obtaining last HTTP response timestamp, err: %w", err)
+	actual := make(map[string]bool)
+	for _, value := range values {
+		for _, v := range splitList(value) {
+			actual[v] = true
+		}
 	}
 
-	lastResTimestamp, ok := lastResTimestampI.(time.Time)
-	if !ok {
-		return fmt.Errorf("last response timestamp: '%+v' should be time.Time", lastReqTimestampI)
+	missing := make([]string, 0)
+	for _, expected := range splitList(expectedValues) {
+		if !actual[expected] {
+			missing = append(missing, expected)
+		}
 	}
 
-	timeBetweenReqRes := lastResTimestamp.Sub(lastReqTimestamp)
-	if timeBetweenReqRes > timeInterval {
-		return fmt.Errorf("time between last request - response should be less than %+v, but it took %+v", timeInterval, timeBetweenReqRes)
+	if len(missing) > 0 {
+		return &AssertionError{Expression: name, Expected: splitList(expectedValues), Actual: values,
+			Err: fmt.Errorf("last HTTP(s) response header '%s' values %q do not contain: %q", name, values, missing)}
 	}
 
 	return nil
 }
 
-// AssertResponseCookieExists checks whether last HTTP(s) response has cookie of given name.
-func (apiCtx *APIContext) AssertResponseCookieExists(name string) error {
-	lastResp, err := apiCtx.GetLastResponse()
+// AssertResponseContentTypeIs checks whether last HTTP response Content-Type header has media type equal to contentTypeTemplate.
+// Media types are compared case-insensitively and regardless of parameters order, charset value is compared case-insensitively,
+// for example: "application/json; charset=utf-8" is equal to "application/json;charset=UTF-8". Only parameters present
+// in contentTypeTemplate are compared, so "application/json" is equal to "application/json; charset=utf-8".
+func (apiCtx *APIContext) AssertResponseContentTypeIs(contentTypeTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseContentTypeIs", contentTypeTemplate)
+
+	contentType, err := apiCtx.TemplateEngine.Replace(contentTypeTemplate, apiCtx.Cache.All())
 	if err != nil {
-		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
+		return fmt.Errorf("template engine has problem with 'content type' template, err: %w", err)
 	}
 
-	defer func() {
-		if apiCtx.Debugger.IsOn() {
+	values, err := apiCtx.getLastResponseHeaderValues("Content-Type")
+	if err != nil {
+		return err
+	}
 
-			apiCtx.Debugger.Print(fmt.Sprintf("last HTTP(s) response cookies: %+v", lastResp.Cookies()))
-		}
-	}()
+	isEqual, err := httpctx.MediaTypesEqual(contentType, values[0])
+	if err != nil {
+		return err
+	}
 
-	for _, cookie := range lastResp.Cookies() {
-		if cookie.Name == name {
-			return nil
-		}
+	if !isEqual {
+		return &AssertionError{Expression: "Content-Type", Expected: contentType, Actual: values[0],
+			Err: fmt.Errorf("last HTTP(s) response has Content-Type '%s', but expected '%s'", values[0], contentType)}
 	}
 
-	return fmt.Errorf("last HTTP(s) response does not have cookie with name '%s'", name)
+	return nil
 }
 
-// AssertResponseCookieNotExists checks whether last HTTP(s) response does not have cookie of given name.
-func (apiCtx *APIContext) AssertResponseCookieNotExists(name string) error {
+// AssertResponseSecurityHeaders checks last HTTP(s) response headers against SecurityHeadersPolicy of APIContext
+// and reports every found violation at once. Policy may be changed with SetSecurityHeadersPolicy.
+func (apiCtx *APIContext) AssertResponseSecurityHeaders() (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseSecurityHeaders")
+
 	lastResp, err := apiCtx.GetLastResponse()
 	if err != nil {
 		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
 	}
 
-	defer func() {
-		if apiCtx.Debugger.IsOn() {
-
-			apiCtx.Debugger.Print(fmt.Sprintf("last HTTP(s) response cookies: %+v", lastResp.Cookies()))
-		}
-	}()
+	if apiCtx.Debugger.IsOn() {
+		apiCtx.Debugger.Print(fmt.Sprintf("security headers policy: %+v", apiCtx.SecurityHeadersPolicy))
+	}
 
-	for _, cookie := range lastResp.Cookies() {
-		if cookie.Name == name {
-			return fmt.Errorf("last HTTP(s) response have cookie with name '%s'", name)
-		}
+	violations := apiCtx.SecurityHeadersPolicy.Violations(lastResp.Header)
+	if len(violations) > 0 {
+		return &AssertionError{Actual: violations,
+			Err: fmt.Errorf("last HTTP(s) response violates security headers policy, found %d violations:\n%s", len(violations), strings.Join(violations, "\n"))}
 	}
 
 	return nil
 }
 
-// AssertResponseCookieValueIs checks whether last HTTP(s) response has cookie of given name and value.
-func (apiCtx *APIContext) AssertResponseCookieValueIs(name, valueTemplate string) error {
-	lastResp, err := apiCtx.GetLastResponse()
+// AssertResponseCORSAllowsOrigin checks whether last HTTP(s) response Access-Control-Allow-Origin header allows
+// requests from origin passed in originTemplate. Wildcard "*" allows every origin, but only without credentials.
+func (apiCtx *APIContext) AssertResponseCORSAllowsOrigin(originTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseCORSAllowsOrigin", originTemplate)
+
+	origin, err := apiCtx.TemplateEngine.Replace(originTemplate, apiCtx.Cache.All())
 	if err != nil {
-		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
+		return fmt.Errorf("template engine has problem with 'origin' template, err: %w", err)
 	}
 
-	defer func() {
-		if apiCtx.Debugger.IsOn() {
-			apiCtx.Debugger.Print(fmt.Sprintf("last HTTP(s) response cookies: %+v", lastResp.Cookies()))
-		}
-	}()
-
-	value, err := apiCtx.TemplateEngine.Replace(valueTemplate, apiCtx.Cache.All())
+	lastResp, err := apiCtx.GetLastResponse()
 	if err != nil {
-		return fmt.Errorf("template engine has problem with 'value' template, err: %w", err)
+		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
 	}
 
-	for _, cookie := range lastResp.Cookies() {
-		if cookie.Name == name && cookie.Value == value {
-			return nil
-		}
+	if err = httpctx.CORSAllowsOrigin(lastResp.Header, origin); err != nil {
+		return &AssertionError{Expression: httpctx.HeaderAllowOrigin, Expected: origin, Actual: lastResp.Header.Get(httpctx.HeaderAllowOrigin), Err: err}
 	}
 
-	return fmt.Errorf("last HTTP(s) response does not have cookie with name '%s' and value: '%s'", name, value)
+	return nil
 }
 
-// AssertResponseCookieValueMatchesRegExp checks whether last HTTP(s) response has cookie of given name and value matching regExp.
-func (apiCtx *APIContext) AssertResponseCookieValueMatchesRegExp(name, regExpTemplate string) error {
+// AssertResponseCORSAllowsMethods checks whether last HTTP(s) response Access-Control-Allow-Methods header allows
+// every method from comma separated list passed in methodsTemplate, for example: "PUT, DELETE".
+// Methods GET, HEAD and POST are always allowed and wildcard "*" allows every method, but only without credentials.
+func (apiCtx *APIContext) AssertResponseCORSAllowsMethods(methodsTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseCORSAllowsMethods", methodsTemplate)
+
+	methods, err := apiCtx.TemplateEngine.Replace(methodsTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'methods' template, err: %w", err)
+	}
+
 	lastResp, err := apiCtx.GetLastResponse()
 	if err != nil {
 		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
 	}
 
-	defer func() {
-		if apiCtx.Debugger.IsOn() {
-			apiCtx.Debugger.Print(fmt.Sprintf("last HTTP(s) response cookies: %#v", lastResp.Cookies()))
-		}
-	}()
+	if err = httpctx.CORSAllowsMethods(lastResp.Header, splitList(methods)); err != nil {
+		return &AssertionError{Expression: httpctx.HeaderAllowMethods, Actual: lastResp.Header.Get(httpctx.HeaderAllowMethods), Err: err}
+	}
 
-	regExp, err := apiCtx.TemplateEngine.Replace(regExpTemplate, apiCtx.Cache.All())
+	return nil
+}
+
+// AssertResponseCORSAllowsHeaders checks whether last HTTP(s) response Access-Control-Allow-Headers header allows
+// every request header from comma separated list passed in headersTemplate, for example: "Content-Type, X-Request-Id".
+// Wildcard "*" allows every header except Authorization, but only without credentials.
+func (apiCtx *APIContext) AssertResponseCORSAllowsHeaders(headersTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseCORSAllowsHeaders", headersTemplate)
+
+	headers, err := apiCtx.TemplateEngine.Replace(headersTemplate, apiCtx.Cache.All())
 	if err != nil {
-		return fmt.Errorf("template engine has problem with 'regExp' template, err: %w", err)
+		return fmt.Errorf("template engine has problem with 'headers' template, err: %w", err)
 	}
 
-	if apiCtx.Debugger.IsOn() {
-		apiCtx.Debugger.Print(fmt.Sprintf("provided regExp template: '%s' was replace to: '%s'", regExpTemplate, regExp))
+	lastResp, err := apiCtx.GetLastResponse()
+	if err != nil {
+		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
 	}
 
-	for _, cookie := range lastResp.Cookies() {
+	if err = httpctx.CORSAllowsHeaders(lastResp.Header, splitList(headers)); err != nil {
+		return &AssertionError{Expression: httpctx.HeaderAllowHeaders, Actual: lastResp.Header.Get(httpctx.HeaderAllowHeaders), Err: err}
+	}
+
+	return nil
+}
+
+// AssertResponseCORSAllowsCredentials checks whether last HTTP(s) response Access-Control-Allow-Credentials header
+// is "true" and Access-Control-Allow-Origin header is not wildcard.
+func (apiCtx *APIContext) AssertResponseCORSAllowsCredentials() (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseCORSAllowsCredentials")
+
+	lastResp, err := apiCtx.GetLastResponse()
+	if err != nil {
+		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
+	}
+
+	if err = httpctx.CORSAllowsCredentials(lastResp.Header); err != nil {
+		return &AssertionError{Expression: httpctx.HeaderAllowCredentials, Actual: lastResp.Header.Get(httpctx.HeaderAllowCredentials), Err: err}
+	}
+
+	return nil
+}
+
+// AssertResponseCORSMaxAgeIsAtLeast checks whether last HTTP(s) response Access-Control-Max-Age header allows
+// caching preflight response for at least timeInterval.
+func (apiCtx *APIContext) AssertResponseCORSMaxAgeIsAtLeast(timeInterval time.Duration) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseCORSMaxAgeIsAtLeast", timeInterval)
+
+	lastResp, err := apiCtx.GetLastResponse()
+	if err != nil {
+		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
+	}
+
+	maxAge, err := httpctx.CORSMaxAge(lastResp.Header)
+	if err != nil {
+		return &AssertionError{Expression: httpctx.HeaderMaxAge, Expected: timeInterval, Actual: lastResp.Header.Get(httpctx.HeaderMaxAge), Err: err}
+	}
+
+	if maxAge < timeInterval {
+		return &AssertionError{Expression: httpctx.HeaderMaxAge, Expected: timeInterval, Actual: maxAge,
+			Err: fmt.Errorf("%s header allows caching preflight response for %s, but expected at least %s", httpctx.HeaderMaxAge, maxAge, timeInterval)}
+	}
+
+	return nil
+}
+
+// AssertResponseCORSVariesByOrigin checks whether last HTTP(s) response Vary header includes Origin,
+// which is required from responses allowing particular origins to be correctly cached.
+func (apiCtx *APIContext) AssertResponseCORSVariesByOrigin() (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseCORSVariesByOrigin")
+
+	lastResp, err := apiCtx.GetLastResponse()
+	if err != nil {
+		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
+	}
+
+	if err = httpctx.CORSVariesByOrigin(lastResp.Header); err != nil {
+		return &AssertionError{Expression: "Vary", Actual: lastResp.Header.Values("Vary"), Err: err}
+	}
+
+	return nil
+}
+
+// AssertResponseIsNotModified checks whether last HTTP(s) response has status code 304 and empty body,
+// what is expected answer to conditional request sent with RequestSendIfNoneMatch or RequestSendIfModifiedSince.
+func (apiCtx *APIContext) AssertResponseIsNotModified() (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseIsNotModified")
+
+	lastResp, err := apiCtx.GetLastResponse()
+	if err != nil {
+		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
+	}
+
+	if lastResp.StatusCode != http.StatusNotModified {
+		return &AssertionError{Expression: StatusCodeExpression, Expected: http.StatusNotModified, Actual: lastResp.StatusCode,
+			Err: fmt.Errorf("expected status code %d, but got %d, %s", http.StatusNotModified, lastResp.StatusCode, apiCtx.lastResponseBodySnippet())}
+	}
+
+	body, err := apiCtx.GetLastResponseBody()
+	if err != nil {
+		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+	}
+
+	if len(body) > 0 {
+		return &AssertionError{Actual: len(body),
+			Err: fmt.Errorf("last HTTP(s) response with status code %d should not have body, %s", http.StatusNotModified, apiCtx.lastResponseBodySnippet())}
+	}
+
+	return nil
+}
+
+// AssertCacheControlDirectiveExists checks whether last HTTP(s) response Cache-Control header has directive
+// passed in directiveTemplate, for example: no-store or private. Directive names are case-insensitive.
+func (apiCtx *APIContext) AssertCacheControlDirectiveExists(directiveTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertCacheControlDirectiveExists", directiveTemplate)
+
+	directive, directives, err := apiCtx.getLastResponseCacheControl(directiveTemplate)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := directives[strings.ToLower(directive)]; !ok {
+		return &AssertionError{Expression: "Cache-Control", Expected: directive, Actual: directives,
+			Err: fmt.Errorf("last HTTP(s) response Cache-Control header does not have %s directive, directives: %v", directive, directives)}
+	}
+
+	return nil
+}
+
+// AssertCacheControlDirectiveNotExists checks whether last HTTP(s) response Cache-Control header does not have
+// directive passed in directiveTemplate, for example: public. Directive names are case-insensitive.
+func (apiCtx *APIContext) AssertCacheControlDirectiveNotExists(directiveTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertCacheControlDirectiveNotExists", directiveTemplate)
+
+	directive, directives, err := apiCtx.getLastResponseCacheControl(directiveTemplate)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := directives[strings.ToLower(directive)]; ok {
+		return &AssertionError{Expression: "Cache-Control", Actual: directives,
+			Err: fmt.Errorf("last HTTP(s) response Cache-Control header has %s directive, directives: %v", directive, directives)}
+	}
+
+	return nil
+}
+
+// AssertCacheControlMaxAgeIsBetween checks whether last HTTP(s) response Cache-Control header directive holding
+// number of seconds, for example: max-age or s-maxage, passed in directiveTemplate, is between from and to inclusive.
+func (apiCtx *APIContext) AssertCacheControlMaxAgeIsBetween(directiveTemplate string, from, to time.Duration) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertCacheControlMaxAgeIsBetween", directiveTemplate, from, to)
+
+	directive, directives, err := apiCtx.getLastResponseCacheControl(directiveTemplate)
+	if err != nil {
+		return err
+	}
+
+	maxAge, err := httpctx.CacheControlSeconds(directives, directive)
+	if err != nil {
+		return &AssertionError{Expression: "Cache-Control", Expected: []time.Duration{from, to}, Err: err}
+	}
+
+	if maxAge < from || maxAge > to {
+		return &AssertionError{Expression: "Cache-Control", Expected: []time.Duration{from, to}, Actual: maxAge,
+			Err: fmt.Errorf("last HTTP(s) response Cache-Control header %s directive is %s, but expected between %s and %s", directive, maxAge, from, to)}
+	}
+
+	return nil
+}
+
+// AssertResponseIsProblemDetails checks whether last HTTP(s) response is well-formed RFC 7807 problem details document:
+// its Content-Type is application/problem+json, body is valid against httpctx.ProblemDetailsSchema and status member,
+// when present, is equal to response status code. typeTemplate, when not empty, is expected value of type member,
+// which defaults to "about:blank". extensionsTemplate, when not empty, is comma separated list of extension members
+// that document should have, for example: "traceId, errors".
+func (apiCtx *APIContext) AssertResponseIsProblemDetails(typeTemplate, extensionsTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseIsProblemDetails", typeTemplate, extensionsTemplate)
+
+	expectedType, err := apiCtx.TemplateEngine.Replace(typeTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'type' template, err: %w", err)
+	}
+
+	extensions, err := apiCtx.TemplateEngine.Replace(extensionsTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'extensions' template, err: %w", err)
+	}
+
+	lastResp, err := apiCtx.GetLastResponse()
+	if err != nil {
+		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
+	}
+
+	if contentType := lastResp.Header.Get("Content-Type"); !httpctx.IsProblemDetailsMediaType(contentType) {
+		return &AssertionError{Expression: "Content-Type", Expected: httpctx.ProblemDetailsMediaType, Actual: contentType,
+			Err: fmt.Errorf("last HTTP(s) response has Content-Type '%s', but expected '%s'", contentType, httpctx.ProblemDetailsMediaType)}
+	}
+
+	body, err := apiCtx.GetLastResponseBody()
+	if err != nil {
+		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+	}
+
+	if err = apiCtx.SchemaValidators.StringValidator.Validate(string(body), httpctx.ProblemDetailsSchema); err != nil {
+		return &AssertionError{DataFormat: df.JSON, Err: fmt.Errorf("last HTTP(s) response body is not valid problem details document, err: %w", err)}
+	}
+
+	if status, err := apiCtx.PathFinders.JSON.Find("status", body); err == nil && status != nil {
+		if apiCtx.nodeValueToString(status) != strconv.Itoa(lastResp.StatusCode) {
+			return &AssertionError{Expression: "status", DataFormat: df.JSON, Expected: lastResp.StatusCode, Actual: status,
+				Err: fmt.Errorf("problem details status member %v is different than last HTTP(s) response status code %d", status, lastResp.StatusCode)}
+		}
+	}
+
+	if expectedType != "" {
+		actualType := httpctx.ProblemDetailsDefaultType
+		if problemType, err := apiCtx.PathFinders.JSON.Find("type", body); err == nil && problemType != nil {
+			actualType = apiCtx.nodeValueToString(problemType)
+		}
+
+		if actualType != expectedType {
+			return &AssertionError{Expression: "type", DataFormat: df.JSON, Expected: expectedType, Actual: actualType,
+				Err: fmt.Errorf("problem details type member is '%s', but expected '%s'", actualType, expectedType)}
+		}
+	}
+
+	missing := make([]string, 0)
+	for _, extension := range splitList(extensions) {
+		if _, err := apiCtx.PathFinders.JSON.Find(extension, body); err != nil {
+			missing = append(missing, extension)
+		}
+	}
+
+	if len(missing) > 0 {
+		return &AssertionError{DataFormat: df.JSON, Actual: missing,
+			Err: fmt.Errorf("problem details document does not have extension members: %q", missing)}
+	}
+
+	return nil
+}
+
+// AssertJWTIsNotExpired checks whether JSON Web Token saved under cacheKey with one of SaveJWT* methods is valid
+// at current time: its exp claim is in the future and nbf claim, when present, is not in the future.
+func (apiCtx *APIContext) AssertJWTIsNotExpired(cacheKey string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertJWTIsNotExpired", cacheKey)
+
+	token, err := apiCtx.getJWT(cacheKey)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	exp, err := token.Time("exp")
+	if err != nil {
+		return &AssertionError{Expression: "exp", Err: err}
+	}
+
+	if !exp.After(now) {
+		return &AssertionError{Expression: "exp", Actual: exp,
+			Err: fmt.Errorf("token saved under key '%s' expired at %s", cacheKey, exp.Format(time.RFC3339))}
+	}
+
+	if _, hasNotBefore := token.Claims["nbf"]; hasNotBefore {
+		nbf, err := token.Time("nbf")
+		if err != nil {
+			return &AssertionError{Expression: "nbf", Err: err}
+		}
+
+		if nbf.After(now) {
+			return &AssertionError{Expression: "nbf", Actual: nbf,
+				Err: fmt.Errorf("token saved under key '%s' is not valid before %s", cacheKey, nbf.Format(time.RFC3339))}
+		}
+	}
+
+	return nil
+}
+
+// AssertJWTTimeClaimIsWithin checks whether time claim, for example: exp, nbf or iat, of JSON Web Token saved under
+// cacheKey with one of SaveJWT* methods differs from current time by no more than timeInterval, in either direction.
+func (apiCtx *APIContext) AssertJWTTimeClaimIsWithin(cacheKey, claim string, timeInterval time.Duration) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertJWTTimeClaimIsWithin", cacheKey, claim, timeInterval)
+
+	token, err := apiCtx.getJWT(cacheKey)
+	if err != nil {
+		return err
+	}
+
+	claimTime, err := token.Time(claim)
+	if err != nil {
+		return &AssertionError{Expression: claim, Err: err}
+	}
+
+	now := time.Now()
+	difference := now.Sub(claimTime)
+	if difference < 0 {
+		difference = -difference
+	}
+
+	if difference > timeInterval {
+		return &AssertionError{Expression: claim, Expected: timeInterval, Actual: difference,
+			Err: fmt.Errorf("token claim '%s' has time %s, which differs from current time %s by %s, expected at most %s",
+				claim, claimTime.Format(time.RFC3339), now.Format(time.RFC3339), difference.Round(time.Second), timeInterval)}
+	}
+
+	return nil
+}
+
+// AssertJWTSignatureIsValidByHMAC checks whether signature of JSON Web Token saved under cacheKey
+// with one of SaveJWT* methods is valid for HMAC secret passed in secretTemplate.
+func (apiCtx *APIContext) AssertJWTSignatureIsValidByHMAC(cacheKey, secretTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertJWTSignatureIsValidByHMAC", cacheKey, secretTemplate)
+
+	secret, err := apiCtx.TemplateEngine.Replace(secretTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'secret' template, err: %w", err)
+	}
+
+	token, err := apiCtx.getJWT(cacheKey)
+	if err != nil {
+		return err
+	}
+
+	if err = token.VerifyHMAC([]byte(secret)); err != nil {
+		return jwtSignatureError(cacheKey, err)
+	}
+
+	return nil
+}
+
+// AssertJWTSignatureIsValidByPEM checks whether signature of JSON Web Token saved under cacheKey
+// with one of SaveJWT* methods is valid for public key or certificate passed in publicKeyTemplate.
+// publicKeyTemplate may hold key in PEM format or full/relative path to file with it.
+func (apiCtx *APIContext) AssertJWTSignatureIsValidByPEM(cacheKey, publicKeyTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertJWTSignatureIsValidByPEM", cacheKey, publicKeyTemplate)
+
+	publicKey, err := apiCtx.TemplateEngine.Replace(publicKeyTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'public key' template, err: %w", err)
+	}
+
+	token, err := apiCtx.getJWT(cacheKey)
+	if err != nil {
+		return err
+	}
+
+	keyData := []byte(publicKey)
+	if !strings.Contains(publicKey, "-----BEGIN") {
+		if keyData, err = os.ReadFile(publicKey); err != nil {
+			return fmt.Errorf("could not read public key file, err: %w", err)
+		}
+	}
+
+	if err = token.VerifyPEM(keyData); err != nil {
+		return jwtSignatureError(cacheKey, err)
+	}
+
+	return nil
+}
+
+// AssertJWTSignatureIsValidByJWKS checks whether signature of JSON Web Token saved under cacheKey
+// with one of SaveJWT* methods is valid for one of keys from JSON Web Key Set file, which full/relative path
+// is passed in pathTemplate. When token has kid header member, only key with the same kid is used.
+func (apiCtx *APIContext) AssertJWTSignatureIsValidByJWKS(cacheKey, pathTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertJWTSignatureIsValidByJWKS", cacheKey, pathTemplate)
+
+	path, err := apiCtx.TemplateEngine.Replace(pathTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'path' template, err: %w", err)
+	}
+
+	token, err := apiCtx.getJWT(cacheKey)
+	if err != nil {
+		return err
+	}
+
+	jwks, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("could not read JWKS file, err: %w", err)
+	}
+
+	if err = token.VerifyJWKS(jwks); err != nil {
+		return jwtSignatureError(cacheKey, err)
+	}
+
+	return nil
+}
+
+// AssertResponsesStatusCodesAre checks whether status codes of responses of requests sent with RequestSendConcurrently
+// occurred exactly given number of times. countsTemplate should be comma separated list of status codes with number
+// of their occurrences, for example: "201: 1, 409: 19". Status codes not present in list should not occur.
+func (apiCtx *APIContext) AssertResponsesStatusCodesAre(countsTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponsesStatusCodesAre", countsTemplate)
+
+	countsString, err := apiCtx.TemplateEngine.Replace(countsTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'counts' template, err: %w", err)
+	}
+
+	expected, err := httpctx.ParseStatusCodeCounts(countsString)
+	if err != nil {
+		return err
+	}
+
+	responses, err := apiCtx.GetLastResponses()
+	if err != nil {
+		return err
+	}
+
+	actual := statusCodeCounts(responses)
+	if httpctx.FormatStatusCodeCounts(expected) != httpctx.FormatStatusCodeCounts(actual) {
+		return &AssertionError{Expression: StatusCodeExpression, Expected: httpctx.FormatStatusCodeCounts(expected), Actual: httpctx.FormatStatusCodeCounts(actual),
+			Err: fmt.Errorf("expected status codes of %d responses: %s, but got: %s",
+				len(responses), httpctx.FormatStatusCodeCounts(expected), httpctx.FormatStatusCodeCounts(actual))}
+	}
+
+	return nil
+}
+
+// AssertResponsesStatusCodeCountIs checks whether exactly count responses of requests sent with RequestSendConcurrently
+// have given status code.
+func (apiCtx *APIContext) AssertResponsesStatusCodeCountIs(code, count int) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponsesStatusCodeCountIs", code, count)
+
+	responses, err := apiCtx.GetLastResponses()
+	if err != nil {
+		return err
+	}
+
+	counts := statusCodeCounts(responses)
+	if counts[code] != count {
+		return &AssertionError{Expression: StatusCodeExpression, Expected: count, Actual: counts[code],
+			Err: fmt.Errorf("expected %d of %d responses with status code %d, but got %d, status codes: %s",
+				count, len(responses), code, counts[code], httpctx.FormatStatusCodeCounts(counts))}
+	}
+
+	return nil
+}
+
+// AssertLoadLatencyPercentileIsAtMost checks whether given percentile of latencies of requests sent with
+// RequestSendWithLoadProfile is less than or equal to timeInterval. Summary is obtained from cache under cacheKey,
+// percentile should be greater than 0 and not greater than 100, for example: 95 or 99.9.
+func (apiCtx *APIContext) AssertLoadLatencyPercentileIsAtMost(cacheKey string, percentile float64, timeInterval time.Duration) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertLoadLatencyPercentileIsAtMost", cacheKey, percentile, timeInterval)
+
+	summary, err := apiCtx.getLoadSummary(cacheKey)
+	if err != nil {
+		return err
+	}
+
+	latency, err := summary.Percentile(percentile)
+	if err != nil {
+		return err
+	}
+
+	if latency > timeInterval {
+		return &AssertionError{Expected: timeInterval, Actual: latency,
+			Err: fmt.Errorf("expected p%v latency to be at most %s, but got %s, %s", percentile, timeInterval, latency, summary)}
+	}
+
+	return nil
+}
+
+// AssertLoadErrorRateIsAtMost checks whether fraction of failed requests sent with RequestSendWithLoadProfile
+// is less than or equal to maxErrorRate, for example: 0.01 allows 1% of errors. Summary is obtained from cache under cacheKey.
+func (apiCtx *APIContext) AssertLoadErrorRateIsAtMost(cacheKey string, maxErrorRate float64) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertLoadErrorRateIsAtMost", cacheKey, maxErrorRate)
+
+	if maxErrorRate < 0 || maxErrorRate > 1 {
+		return fmt.Errorf("error rate should be between 0 and 1, got %v", maxErrorRate)
+	}
+
+	summary, err := apiCtx.getLoadSummary(cacheKey)
+	if err != nil {
+		return err
+	}
+
+	if summary.ErrorRate > maxErrorRate {
+		return &AssertionError{Expected: maxErrorRate, Actual: summary.ErrorRate,
+			Err: fmt.Errorf("expected error rate to be at most %v, but got %v, status codes: %s, %s",
+				maxErrorRate, summary.ErrorRate, httpctx.FormatStatusCodeCounts(summary.StatusCodes), summary)}
+	}
+
+	return nil
+}
+
+// AssertLoadThroughputIsAtLeast checks whether number of requests sent with RequestSendWithLoadProfile completed
+// per second is greater than or equal to minThroughput. Summary is obtained from cache under cacheKey.
+func (apiCtx *APIContext) AssertLoadThroughputIsAtLeast(cacheKey string, minThroughput float64) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertLoadThroughputIsAtLeast", cacheKey, minThroughput)
+
+	summary, err := apiCtx.getLoadSummary(cacheKey)
+	if err != nil {
+		return err
+	}
+
+	if summary.Throughput < minThroughput {
+		return &AssertionError{Expected: minThroughput, Actual: summary.Throughput,
+			Err: fmt.Errorf("expected throughput to be at least %.2f req/s, but got %.2f req/s, %s", minThroughput, summary.Throughput, summary)}
+	}
+
+	return nil
+}
+
+// AssertRequestRejectsSchemaViolationsByString sends previously prepared request once for each variant of body from
+// bodyTemplate that violates JSON schema provided in schemaTemplate, for example: without required property,
+// with value of wrong type, with number out of range, with string not matching pattern or with unexpected property.
+// Every variant should be answered with statusCode, mutations that were accepted are listed in returned error.
+// bodyTemplate should be in JSON format and valid against schema.
+func (apiCtx *APIContext) AssertRequestRejectsSchemaViolationsByString(cacheKey, bodyTemplate, schemaTemplate string, statusCode int) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertRequestRejectsSchemaViolationsByString", cacheKey, bodyTemplate, schemaTemplate, statusCode)
+
+	return apiCtx.assertRequestRejectsSchemaViolationsGeneral(cacheKey, bodyTemplate, schemaTemplate, statusCode, apiCtx.SchemaGenerators.StringGenerator, apiCtx.SchemaValidators.StringValidator)
+}
+
+// AssertRequestRejectsSchemaViolationsByReference works like AssertRequestRejectsSchemaViolationsByString
+// but JSON schema is provided in referenceTemplate as URL or full/relative path.
+func (apiCtx *APIContext) AssertRequestRejectsSchemaViolationsByReference(cacheKey, bodyTemplate, referenceTemplate string, statusCode int) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertRequestRejectsSchemaViolationsByReference", cacheKey, bodyTemplate, referenceTemplate, statusCode)
+
+	return apiCtx.assertRequestRejectsSchemaViolationsGeneral(cacheKey, bodyTemplate, referenceTemplate, statusCode, apiCtx.SchemaGenerators.ReferenceGenerator, apiCtx.SchemaValidators.ReferenceValidator)
+}
+
+// AssertResponseMatchesSchemaByReference validates last response body against schema as provided in referenceTemplate.
+// referenceTemplate may be: URL or full/relative path
+func (apiCtx *APIContext) AssertResponseMatchesSchemaByReference(referenceTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseMatchesSchemaByReference", referenceTemplate)
+
+	reference, err := apiCtx.TemplateEngine.Replace(referenceTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'reference' template, err: %w", err)
+	}
+
+	body, err := apiCtx.GetLastResponseBody()
+	if err != nil {
+		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+	}
+
+	if apiCtx.Debugger.IsOn() {
+		apiCtx.Debugger.Print(fmt.Sprintf("'%s' was replaced as: '%s'\n", referenceTemplate, reference))
+	}
+
+	return schemaValidationError("", df.JSON, apiCtx.SchemaValidators.ReferenceValidator.Validate(string(body), reference))
+}
+
+// AssertResponseMatchesSchemaByString validates last response body against schema.
+func (apiCtx *APIContext) AssertResponseMatchesSchemaByString(schema string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseMatchesSchemaByString", schema)
+
+	body, err := apiCtx.GetLastResponseBody()
+	if err != nil {
+		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+	}
+
+	return schemaValidationError("", df.JSON, apiCtx.SchemaValidators.StringValidator.Validate(string(body), schema))
+}
+
+// AssertNodeMatchesSchemaByString validates last response body JSON node against schema
+func (apiCtx *APIContext) AssertNodeMatchesSchemaByString(dataFormat df.DataFormat, exprTemplate, schemaTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeMatchesSchemaByString", dataFormat, exprTemplate, schemaTemplate)
+
+	return apiCtx.iValidateNodeWithSchemaGeneral(dataFormat, exprTemplate, schemaTemplate, apiCtx.SchemaValidators.StringValidator)
+}
+
+// AssertNodeMatchesSchemaByReference validates last response body node against schema as provided in referenceTemplate
+func (apiCtx *APIContext) AssertNodeMatchesSchemaByReference(dataFormat df.DataFormat, exprTemplate, referenceTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertNodeMatchesSchemaByReference", dataFormat, exprTemplate, referenceTemplate)
+
+	return apiCtx.iValidateNodeWithSchemaGeneral(dataFormat, exprTemplate, referenceTemplate, apiCtx.SchemaValidators.ReferenceValidator)
+}
+
+// AssertTimeBetweenRequestAndResponseIs asserts that last HTTP request-response time
+// is <= than expected timeInterval.
+// timeInterval should be string acceptable by time.ParseDuration func
+func (apiCtx *APIContext) AssertTimeBetweenRequestAndResponseIs(timeInterval time.Duration) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertTimeBetweenRequestAndResponseIs", timeInterval)
+
+	lastReqTimestampI, err := apiCtx.Cache.GetSaved(httpcache.LastHTTPRequestTimestamp)
+	if err != nil {
+		return fmt.Errorf("problem during obtaining last HTTP request timestamp, err: %w", err)
+	}
+
+	lastReqTimestamp, ok := lastReqTimestampI.(time.Time)
+	if !ok {
+		return fmt.Errorf("last request timestamp: '%+v' should be time.Time", lastReqTimestampI)
+	}
+
+	lastResTimestampI, err := apiCtx.Cache.GetSaved(httpcache.LastHTTPResponseTimestamp)
+	if err != nil {
+		return fmt.Errorf("problem during obtaining last HTTP response timestamp, err: %w", err)
+	}
+
+	lastResTimestamp, ok := lastResTimestampI.(time.Time)
+	if !ok {
+		return fmt.Errorf("last response timestamp: '%+v' should be time.Time", lastReqTimestampI)
+	}
+
+	timeBetweenReqRes := lastResTimestamp.Sub(lastReqTimestamp)
+	if timeBetweenReqRes > timeInterval {
+		return &AssertionError{Expected: timeInterval, Actual: timeBetweenReqRes,
+			Err: fmt.Errorf("time between last request - response should be less than %+v, but it took %+v", timeInterval, timeBetweenReqRes)}
+	}
+
+	return nil
+}
+
+// AssertResponseTimingPhaseIsAtMost checks whether phase of last HTTP(s) request took less than or equal to timeInterval.
+// phase is case-insensitive name of httpctx.Timing phase: DNSLookup, Connect, TLSHandshake, ServerProcessing,
+// TimeToFirstByte, BodyDownload or Total.
+func (apiCtx *APIContext) AssertResponseTimingPhaseIsAtMost(phase string, timeInterval time.Duration) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseTimingPhaseIsAtMost", phase, timeInterval)
+
+	timing, err := apiCtx.GetLastResponseTiming()
+	if err != nil {
+		return err
+	}
+
+	duration, err := timing.Phase(phase)
+	if err != nil {
+		return err
+	}
+
+	if duration > timeInterval {
+		return &AssertionError{Expression: phase, Expected: timeInterval, Actual: duration,
+			Err: fmt.Errorf("expected %s of last HTTP(s) request to take at most %s, but it took %s, %s", phase, timeInterval, duration, timing)}
+	}
+
+	return nil
+}
+
+// AssertResponseContentLengthIs checks whether content length of last HTTP(s) response is equal to length.
+// Content length is taken from http.Response.ContentLength, so it fails when length is unknown, for example for chunked response.
+func (apiCtx *APIContext) AssertResponseContentLengthIs(length int) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseContentLengthIs", length)
+
+	lastResp, err := apiCtx.GetLastResponse()
+	if err != nil {
+		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
+	}
+
+	actual := lastResp.ContentLength
+	if actual < 0 {
+		return &AssertionError{Expression: "Content-Length", Expected: int64(length), Actual: actual,
+			Err: fmt.Errorf("last HTTP(s) response has unknown content length")}
+	}
+
+	if actual != int64(length) {
+		return &AssertionError{Expression: "Content-Length", Expected: int64(length), Actual: actual,
+			Err: fmt.Errorf("expected Content-Length of last HTTP(s) response to be %d, but got %d", length, actual)}
+	}
+
+	return nil
+}
+
+// AssertResponseBodySizeIs checks whether number of bytes read from last HTTP(s) response body is equal to size.
+// When response body was written to temporary file, it is not loaded into memory.
+func (apiCtx *APIContext) AssertResponseBodySizeIs(size int) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseBodySizeIs", size)
+
+	actual, err := apiCtx.getLastResponseBodySize()
+	if err != nil {
+		return err
+	}
+
+	if actual != int64(size) {
+		return &AssertionError{Expected: int64(size), Actual: actual,
+			Err: fmt.Errorf("expected last HTTP(s) response body size to be %d bytes, but got %d bytes", size, actual)}
+	}
+
+	return nil
+}
+
+// AssertResponseBodySizeIsAtMost checks whether number of bytes read from last HTTP(s) response body is less than
+// or equal to size. When response body was written to temporary file, it is not loaded into memory.
+func (apiCtx *APIContext) AssertResponseBodySizeIsAtMost(size int) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseBodySizeIsAtMost", size)
+
+	actual, err := apiCtx.getLastResponseBodySize()
+	if err != nil {
+		return err
+	}
+
+	if actual > int64(size) {
+		return &AssertionError{Expected: int64(size), Actual: actual,
+			Err: fmt.Errorf("expected last HTTP(s) response body size to be at most %d bytes, but got %d bytes", size, actual)}
+	}
+
+	return nil
+}
+
+// AssertResponseCookieExists checks whether last HTTP(s) response has cookie of given name.
+func (apiCtx *APIContext) AssertResponseCookieExists(name string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseCookieExists", name)
+
+	lastResp, err := apiCtx.GetLastResponse()
+	if err != nil {
+		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
+	}
+
+	defer func() {
+		if apiCtx.Debugger.IsOn() {
+
+			apiCtx.Debugger.Print(fmt.Sprintf("last HTTP(s) response cookies: %+v", lastResp.Cookies()))
+		}
+	}()
+
+	for _, cookie := range lastResp.Cookies() {
+		if cookie.Name == name {
+			return nil
+		}
+	}
+
+	return &AssertionError{Expression: name, Err: fmt.Errorf("last HTTP(s) response does not have cookie with name '%s'", name)}
+}
+
+// AssertResponseCookieNotExists checks whether last HTTP(s) response does not have cookie of given name.
+func (apiCtx *APIContext) AssertResponseCookieNotExists(name string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseCookieNotExists", name)
+
+	lastResp, err := apiCtx.GetLastResponse()
+	if err != nil {
+		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
+	}
+
+	defer func() {
+		if apiCtx.Debugger.IsOn() {
+
+			apiCtx.Debugger.Print(fmt.Sprintf("last HTTP(s) response cookies: %+v", lastResp.Cookies()))
+		}
+	}()
+
+	for _, cookie := range lastResp.Cookies() {
+		if cookie.Name == name {
+			return &AssertionError{Expression: name, Err: fmt.Errorf("last HTTP(s) response have cookie with name '%s'", name)}
+		}
+	}
+
+	return nil
+}
+
+// AssertResponseCookieValueIs checks whether last HTTP(s) response has cookie of given name and value.
+func (apiCtx *APIContext) AssertResponseCookieValueIs(name, valueTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseCookieValueIs", name, valueTemplate)
+
+	lastResp, err := apiCtx.GetLastResponse()
+	if err != nil {
+		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
+	}
+
+	defer func() {
+		if apiCtx.Debugger.IsOn() {
+			apiCtx.Debugger.Print(fmt.Sprintf("last HTTP(s) response cookies: %+v", lastResp.Cookies()))
+		}
+	}()
+
+	value, err := apiCtx.TemplateEngine.Replace(valueTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'value' template, err: %w", err)
+	}
+
+	for _, cookie := range lastResp.Cookies() {
+		if cookie.Name == name && cookie.Value == value {
+			return nil
+		}
+	}
+
+	return &AssertionError{Expression: name, Expected: value, Err: fmt.Errorf("last HTTP(s) response does not have cookie with name '%s' and value: '%s'", name, value)}
+}
+
+// AssertResponseCookieValueMatchesRegExp checks whether last HTTP(s) response has cookie of given name and value matching regExp.
+func (apiCtx *APIContext) AssertResponseCookieValueMatchesRegExp(name, regExpTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseCookieValueMatchesRegExp", name, regExpTemplate)
+
+	lastResp, err := apiCtx.GetLastResponse()
+	if err != nil {
+		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
+	}
+
+	defer func() {
+		if apiCtx.Debugger.IsOn() {
+			apiCtx.Debugger.Print(fmt.Sprintf("last HTTP(s) response cookies: %#v", lastResp.Cookies()))
+		}
+	}()
+
+	regExp, err := apiCtx.TemplateEngine.Replace(regExpTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'regExp' template, err: %w", err)
+	}
+
+	if apiCtx.Debugger.IsOn() {
+		apiCtx.Debugger.Print(fmt.Sprintf("provided regExp template: '%s' was replace to: '%s'", regExpTemplate, regExp))
+	}
+
+	for _, cookie := range lastResp.Cookies() {
+		if cookie.Name == name {
+			matched, err := regexp.Match(regExp, []byte(cookie.Value))
+			if err != nil {
+				return fmt.Errorf("problem with regExp matching, err: %w", err)
+			}
+
+			if !matched {
+				return &AssertionError{Expression: name, Expected: regExp, Actual: cookie.Value,
+					Err: fmt.Errorf("%s does not contain any: %s", cookie.Value, regExp)}
+			}
+
+			return nil
+		}
+	}
+
+	return &AssertionError{Expression: name, Err: fmt.Errorf("last HTTP(s) response does not have cookie with name '%s'", name)}
+}
+
+// AssertResponseCookieValueNotMatchesRegExp checks whether last HTTP(s) response has cookie of given name and value
+// is not matching provided regExp.
+func (apiCtx *APIContext) AssertResponseCookieValueNotMatchesRegExp(name, regExpTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseCookieValueNotMatchesRegExp", name, regExpTemplate)
+
+	lastResp, err := apiCtx.GetLastResponse()
+	if err != nil {
+		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
+	}
+
+	defer func() {
+		if apiCtx.Debugger.IsOn() {
+			apiCtx.Debugger.Print(fmt.Sprintf("last HTTP(s) response cookies: %#v", lastResp.Cookies()))
+		}
+	}()
+
+	regExp, err := apiCtx.TemplateEngine.Replace(regExpTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'regExp' template, err: %w", err)
+	}
+
+	if apiCtx.Debugger.IsOn() {
+		apiCtx.Debugger.Print(fmt.Sprintf("provided regExp template: '%s' was replace to: '%s'", regExpTemplate, regExp))
+	}
+
+	for _, cookie := range lastResp.Cookies() {
 		if cookie.Name == name {
 			matched, err := regexp.Match(regExp, []byte(cookie.Value))
 			if err != nil {
-				return fmt.Errorf("problem with regExp matching, err: %w", err)
+				return fmt.Errorf("problem with regExp matching, err: %w", err)
+			}
+
+			if !matched {
+				return nil
+			}
+
+			return &AssertionError{Expression: name, Actual: cookie.Value,
+				Err: fmt.Errorf("%s contains some: %s, but expected not to", cookie.Value, regExp)}
+		}
+	}
+
+	return &AssertionError{Expression: name, Err: fmt.Errorf("last HTTP(s) response does not have cookie with name '%s'", name)}
+}
+
+// AssertResponseCookieIsSecure checks whether last HTTP(s) response has cookie of given name with Secure attribute.
+func (apiCtx *APIContext) AssertResponseCookieIsSecure(name string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseCookieIsSecure", name)
+
+	cookie, err := apiCtx.getLastResponseCookie(name)
+	if err != nil {
+		return err
+	}
+
+	if !cookie.Secure {
+		return &AssertionError{Expression: name, Expected: true, Actual: false, Err: fmt.Errorf("last HTTP(s) response cookie '%s' does not have Secure attribute", name)}
+	}
+
+	return nil
+}
+
+// AssertResponseCookieIsHttpOnly checks whether last HTTP(s) response has cookie of given name with HttpOnly attribute.
+func (apiCtx *APIContext) AssertResponseCookieIsHttpOnly(name string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseCookieIsHttpOnly", name)
+
+	cookie, err := apiCtx.getLastResponseCookie(name)
+	if err != nil {
+		return err
+	}
+
+	if !cookie.HttpOnly {
+		return &AssertionError{Expression: name, Expected: true, Actual: false, Err: fmt.Errorf("last HTTP(s) response cookie '%s' does not have HttpOnly attribute", name)}
+	}
+
+	return nil
+}
+
+// AssertResponseCookieSameSiteIs checks whether last HTTP(s) response has cookie of given name with SameSite attribute
+// equal to sameSite. Available values are: Strict, Lax and None, comparison is case-insensitive.
+func (apiCtx *APIContext) AssertResponseCookieSameSiteIs(name, sameSite string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseCookieSameSiteIs", name, sameSite)
+
+	cookie, err := apiCtx.getLastResponseCookie(name)
+	if err != nil {
+		return err
+	}
+
+	var actual string
+	switch cookie.SameSite {
+	case http.SameSiteStrictMode:
+		actual = "Strict"
+	case http.SameSiteLaxMode:
+		actual = "Lax"
+	case http.SameSiteNoneMode:
+		actual = "None"
+	}
+
+	if !strings.EqualFold(actual, sameSite) {
+		return &AssertionError{Expression: name, Expected: sameSite, Actual: actual,
+			Err: fmt.Errorf("last HTTP(s) response cookie '%s' has SameSite attribute '%s', but expected '%s'", name, actual, sameSite)}
+	}
+
+	return nil
+}
+
+// AssertResponseCookiePathIs checks whether last HTTP(s) response has cookie of given name with Path attribute equal to pathTemplate.
+func (apiCtx *APIContext) AssertResponseCookiePathIs(name, pathTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseCookiePathIs", name, pathTemplate)
+
+	path, err := apiCtx.TemplateEngine.Replace(pathTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'path' template, err: %w", err)
+	}
+
+	cookie, err := apiCtx.getLastResponseCookie(name)
+	if err != nil {
+		return err
+	}
+
+	if cookie.Path != path {
+		return &AssertionError{Expression: name, Expected: path, Actual: cookie.Path,
+			Err: fmt.Errorf("last HTTP(s) response cookie '%s' has Path attribute '%s', but expected '%s'", name, cookie.Path, path)}
+	}
+
+	return nil
+}
+
+// AssertResponseCookieDomainIs checks whether last HTTP(s) response has cookie of given name with Domain attribute
+// equal to domainTemplate. Leading dot of Domain attribute is ignored.
+func (apiCtx *APIContext) AssertResponseCookieDomainIs(name, domainTemplate string) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseCookieDomainIs", name, domainTemplate)
+
+	domain, err := apiCtx.TemplateEngine.Replace(domainTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'domain' template, err: %w", err)
+	}
+
+	cookie, err := apiCtx.getLastResponseCookie(name)
+	if err != nil {
+		return err
+	}
+
+	if !strings.EqualFold(strings.TrimPrefix(cookie.Domain, "."), strings.TrimPrefix(domain, ".")) {
+		return &AssertionError{Expression: name, Expected: domain, Actual: cookie.Domain,
+			Err: fmt.Errorf("last HTTP(s) response cookie '%s' has Domain attribute '%s', but expected '%s'", name, cookie.Domain, domain)}
+	}
+
+	return nil
+}
+
+// AssertResponseCookieExpiresWithin checks whether last HTTP(s) response has cookie of given name, which expires
+// no later than timeInterval from now. Max-Age attribute takes precedence over Expires attribute.
+// Session cookie, without both of these attributes, does not satisfy assertion.
+func (apiCtx *APIContext) AssertResponseCookieExpiresWithin(name string, timeInterval time.Duration) (err error) {
+	defer apiCtx.completeAssertion(&err, "AssertResponseCookieExpiresWithin", name, timeInterval)
+
+	cookie, err := apiCtx.getLastResponseCookie(name)
+	if err != nil {
+		return err
+	}
+
+	var lifetime time.Duration
+	switch {
+	case cookie.MaxAge != 0:
+		lifetime = time.Duration(cookie.MaxAge) * time.Second
+	case !cookie.Expires.IsZero():
+		lifetime = time.Until(cookie.Expires)
+	default:
+		return &AssertionError{Expression: name,
+			Err: fmt.Errorf("last HTTP(s) response cookie '%s' is session cookie without Max-Age and Expires attributes", name)}
+	}
+
+	if lifetime > timeInterval {
+		return &AssertionError{Expression: name, Expected: timeInterval, Actual: lifetime,
+			Err: fmt.Errorf("last HTTP(s) response cookie '%s' expires in %s, but expected to expire within %s", name, lifetime.Round(time.Second), timeInterval)}
+	}
+
+	return nil
+}
+
+// AssertAll reports every failure recorded by Assert* methods in soft assertion mode and clears recorded failures.
+// Each failure is described by assertion with its arguments and error message. See SetSoftAssertionMode.
+func (apiCtx *APIContext) AssertAll() error {
+	failures := apiCtx.softAssertionFailures
+	apiCtx.softAssertionFailures = nil
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%d soft assertions failed:", len(failures)))
+	for i, failure := range failures {
+		sb.WriteString(fmt.Sprintf("\n%d) %s\n   %s", i+1, failure.Assertion, failure.Err))
+	}
+
+	return errors.New(sb.String())
+}
+
+// SaveCookie saves value of last HTTP(s) response cookie of given name under given cache key.
+func (apiCtx *APIContext) SaveCookie(name, cacheKey string) error {
+	cookie, err := apiCtx.getLastResponseCookie(name)
+	if err != nil {
+		return err
+	}
+
+	apiCtx.Cache.Save(cacheKey, cookie.Value)
+
+	return nil
+}
+
+// SaveJWTFromNode decodes JSON Web Token from last HTTP(s) response body node and saves it under given cache key
+// as jwt.Token. Decoded header and claims are available in templates, for example: {{.TOKEN.Claims.sub}},
+// and may be checked with node assertions after SaveJWTPart.
+// Signature is not verified, see AssertJWTSignatureIsValidByHMAC.
+func (apiCtx *APIContext) SaveJWTFromNode(dataFormat df.DataFormat, exprTemplate, cacheKey string) error {
+	body, err := apiCtx.getNodeSource()
+	if err != nil {
+		return err
+	}
+
+	expr, err := apiCtx.TemplateEngine.Replace(exprTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'expression' template, err: %w", err)
+	}
+
+	node, err := apiCtx.getNode(body, expr, dataFormat, types.String)
+	if err != nil {
+		return err
+	}
+
+	return apiCtx.saveJWT(fmt.Sprintf("%v", node), cacheKey)
+}
+
+// SaveJWTFromHeader decodes JSON Web Token from last HTTP(s) response header of given name and saves it under
+// given cache key as jwt.Token. Optional "Bearer " prefix of header value is skipped.
+func (apiCtx *APIContext) SaveJWTFromHeader(name, cacheKey string) error {
+	values, err := apiCtx.getLastResponseHeaderValues(name)
+	if err != nil {
+		return err
+	}
+
+	token := values[0]
+	if len(token) > len("Bearer ") && strings.EqualFold(token[:len("Bearer ")], "Bearer ") {
+		token = token[len("Bearer "):]
+	}
+
+	return apiCtx.saveJWT(token, cacheKey)
+}
+
+// SaveJWTFromCache decodes JSON Web Token saved as string under tokenCacheKey and saves it under given cache key
+// as jwt.Token.
+func (apiCtx *APIContext) SaveJWTFromCache(tokenCacheKey, cacheKey string) error {
+	value, err := apiCtx.Cache.GetSaved(tokenCacheKey)
+	if err != nil {
+		return fmt.Errorf("could not obtain %s from cache, err: %w", tokenCacheKey, err)
+	}
+
+	token, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("value saved in cache under key '%s' should be string, got %T", tokenCacheKey, value)
+	}
+
+	return apiCtx.saveJWT(token, cacheKey)
+}
+
+// SaveJWTPart saves header or claims of JSON Web Token saved under cacheKey as JSON document under documentCacheKey.
+// part should be "header" or "claims". Document may be checked with node assertions after SetCachedDocumentAsNodeSource.
+func (apiCtx *APIContext) SaveJWTPart(cacheKey, part, documentCacheKey string) error {
+	token, err := apiCtx.getJWT(cacheKey)
+	if err != nil {
+		return err
+	}
+
+	var document map[string]any
+	switch strings.ToLower(strings.TrimSpace(part)) {
+	case "header":
+		document = token.Header
+	case "claims":
+		document = token.Claims
+	default:
+		return fmt.Errorf("invalid JSON Web Token part: '%s', expected one of: header, claims", part)
+	}
+
+	data, err := apiCtx.Serializers.JSON.Serialize(document)
+	if err != nil {
+		return fmt.Errorf("could not serialize JSON Web Token %s, err: %w", part, err)
+	}
+
+	apiCtx.Cache.Save(documentCacheKey, string(data))
+
+	return nil
+}
+
+// SetCachedDocumentAsNodeSource makes node assertions and SaveNode read nodes from document saved under cacheKey
+// instead of last HTTP(s) response body, for example: JSON Web Token claims saved with SaveJWTPart or items collected
+// with RequestSendPaginatedByLink. Document saved as string or []byte is used as it is, other values are serialized
+// to JSON. Last HTTP(s) response is not changed and SetLastResponseAsNodeSource restores it as source of nodes.
+func (apiCtx *APIContext) SetCachedDocumentAsNodeSource(cacheKey string) error {
+	if _, err := apiCtx.getCachedDocument(cacheKey); err != nil {
+		return err
+	}
+
+	apiCtx.nodeSourceCacheKey = cacheKey
+
+	return nil
+}
+
+// SetLastResponseAsNodeSource makes node assertions and SaveNode read nodes from last HTTP(s) response body again,
+// after SetCachedDocumentAsNodeSource.
+func (apiCtx *APIContext) SetLastResponseAsNodeSource() error {
+	apiCtx.nodeSourceCacheKey = ""
+
+	return nil
+}
+
+// Save saves into cache arbitrary passed data.
+func (apiCtx *APIContext) Save(valueTemplate, cacheKey string) error {
+	if len(valueTemplate) == 0 {
+		return fmt.Errorf("pass any value")
+	}
+
+	if len(cacheKey) == 0 {
+		return fmt.Errorf("cacheKey should not be empty value")
+	}
+
+	value, err := apiCtx.TemplateEngine.Replace(valueTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'value' template, err: %w", err)
+	}
+
+	apiCtx.Cache.Save(cacheKey, value)
+
+	return nil
+}
+
+// SaveNode saves from last response body node under given cache key.
+// expr should be valid according to injected PathResolver of given data type
+func (apiCtx *APIContext) SaveNode(dataFormat df.DataFormat, exprTemplate, cacheKey string) error {
+	body, err := apiCtx.getNodeSource()
+	if err != nil {
+		return err
+	}
+
+	expr, err := apiCtx.TemplateEngine.Replace(exprTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'expression' template, err: %w", err)
+	}
+
+	iVal, err := apiCtx.getNode(body, expr, dataFormat, types.Any)
+	if err != nil {
+		if apiCtx.Debugger.IsOn() {
+			apiCtx.Debugger.Print(fmt.Sprintf("last response body:\n\n"))
+			apiCtx.Debugger.Print(string(body))
+		}
+
+		return err
+	}
+
+	apiCtx.Cache.Save(cacheKey, iVal)
+
+	return nil
+}
+
+// SaveNodeTime saves time from last response body node under given cache key as time.Time.
+// layout may be any layout accepted by time.Parse, "unix" or "unixmilli", empty layout means RFC3339.
+func (apiCtx *APIContext) SaveNodeTime(dataFormat df.DataFormat, exprTemplate, layout, cacheKey string) error {
+	if len(cacheKey) == 0 {
+		return fmt.Errorf("cacheKey should not be empty value")
+	}
+
+	nodeTime, _, err := apiCtx.getNodeTime(dataFormat, exprTemplate, layout)
+	if err != nil {
+		return err
+	}
+
+	apiCtx.Cache.Save(cacheKey, nodeTime)
+
+	return nil
+}
+
+// SaveHeader saves from last response header value under given cache key
+func (apiCtx *APIContext) SaveHeader(name, cacheKey string) error {
+	defer func() {
+		if apiCtx.Debugger.IsOn() {
+			lastResp, err := apiCtx.GetLastResponse()
+			if err != nil {
+				apiCtx.Debugger.Print("could not obtain last response headers")
+			}
+
+			apiCtx.Debugger.Print(fmt.Sprintf("last HTTP(s) response headers: %#v", lastResp.Header))
+		}
+	}()
+
+	lastResp, err := apiCtx.GetLastResponse()
+	if err != nil {
+		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
+	}
+
+	header := lastResp.Header.Get(name)
+	if header == "" {
+		return fmt.Errorf("could not find header %s in last HTTP(s) response", name)
+	}
+
+	apiCtx.Cache.Save(cacheKey, header)
+
+	return nil
+}
+
+// SaveHeaderRegExpMatch saves under given cache key part of last HTTP(s) response header value captured by first
+// capturing group of regExpTemplate or whole match, when regExpTemplate has no groups. When header is repeated,
+// first matching value is used. For example, regExp "max-age=(\d+)" applied to Cache-Control header saves "3600".
+func (apiCtx *APIContext) SaveHeaderRegExpMatch(name, regExpTemplate, cacheKey string) error {
+	regExpString, err := apiCtx.TemplateEngine.Replace(regExpTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'regExp' template, err: %w", err)
+	}
+
+	regExp, err := regexp.Compile(regExpString)
+	if err != nil {
+		return fmt.Errorf("could not compile regExp '%s', err: %w", regExpString, err)
+	}
+
+	values, err := apiCtx.getLastResponseHeaderValues(name)
+	if err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		match := regExp.FindStringSubmatchIndex(value)
+		if match == nil {
+			continue
+		}
+
+		if len(match) == 2 {
+			apiCtx.Cache.Save(cacheKey, value[match[0]:match[1]])
+
+			return nil
+		}
+
+		if match[2] < 0 {
+			return fmt.Errorf("first capturing group of regExp '%s' does not participate in match of last HTTP(s) response header '%s' value '%s'", regExpString, name, value)
+		}
+
+		apiCtx.Cache.Save(cacheKey, value[match[2]:match[3]])
+
+		return nil
+	}
+
+	return fmt.Errorf("last HTTP(s) response header '%s' values %q do not match regExp: '%s'", name, values, regExpString)
+}
+
+// Wait waits for given timeInterval amount of time
+func (apiCtx *APIContext) Wait(timeInterval time.Duration) error {
+	time.Sleep(timeInterval)
+
+	return nil
+}
+
+// DebugPrintResponseBody prints last response from request.
+func (apiCtx *APIContext) DebugPrintResponseBody() error {
+	var tmp any
+
+	body, err := apiCtx.GetLastResponseBody()
+	if err != nil {
+		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			apiCtx.Debugger.Print(string(body))
+		}
+	}()
+
+	if df.IsYAML(body) {
+		err = apiCtx.Serializers.YAML.Deserialize(body, &tmp)
+		if err != nil {
+			return nil
+		}
+
+		indentedRespBody, err := yaml.Marshal(tmp)
+		if err != nil {
+			return nil
+		}
+
+		apiCtx.Debugger.Print(string(indentedRespBody))
+		return nil
+	}
+
+	if df.IsJSON(body) {
+		err = apiCtx.Serializers.JSON.Deserialize(body, &tmp)
+		if err != nil {
+			return nil
+		}
+
+		indentedRespBody, err := json.MarshalIndent(tmp, "", "\t")
+		if err != nil {
+			return nil
+		}
+
+		apiCtx.Debugger.Print(string(indentedRespBody))
+		return nil
+	}
+
+	if df.IsXML(body) {
+		err = apiCtx.Serializers.XML.Deserialize(body, &tmp)
+		if err != nil {
+			return nil
+		}
+
+		indentedRespBody, err := xml.MarshalIndent(tmp, "", "\t")
+		if err != nil {
+			return nil
+		}
+
+		apiCtx.Debugger.Print(string(indentedRespBody))
+	}
+
+	apiCtx.Debugger.Print(string(body))
+	return nil
+}
+
+// DebugStart starts debugging mode
+func (apiCtx *APIContext) DebugStart() error {
+	apiCtx.Debugger.TurnOn()
+
+	return nil
+}
+
+// DebugStop stops debugging mode
+func (apiCtx *APIContext) DebugStop() error {
+	apiCtx.Debugger.TurnOff()
+
+	return nil
+}
+
+// GetPreparedRequest returns prepared request from cache or error if failed
+func (apiCtx *APIContext) GetPreparedRequest(cacheKey string) (*http.Request, error) {
+	reqInterface, err := apiCtx.Cache.GetSaved(cacheKey)
+	if err != nil {
+		return &http.Request{}, fmt.Errorf("could not obtain %s from cache, err: %w", cacheKey, err)
+	}
+
+	req, ok := reqInterface.(*http.Request)
+	if !ok {
+		return &http.Request{}, fmt.Errorf("value under key %s in cache doesn't contain *http.Request", cacheKey)
+	}
+
+	return req, nil
+}
+
+// GetLastResponse returns last HTTP(s) response.
+func (apiCtx *APIContext) GetLastResponse() (*http.Response, error) {
+	respInterface, err := apiCtx.Cache.GetSaved(httpcache.LastHTTPResponseCacheKey)
+	if err != nil {
+		return nil, fmt.Errorf("missing last HTTP(s) response, err: %s", err.Error())
+	}
+
+	lastResp, ok := respInterface.(*http.Response)
+	if !ok {
+		return nil, fmt.Errorf("last HTTP(s) response data structure is not type *http.Response")
+	}
+
+	if lastResp == nil {
+		return nil, fmt.Errorf("missing last HTTP(s) response")
+	}
+
+	return lastResp, nil
+}
+
+// GetLastResponses returns HTTP(s) responses of requests sent with RequestSendConcurrently.
+func (apiCtx *APIContext) GetLastResponses() ([]*http.Response, error) {
+	responses, err := apiCtx.Cache.GetSaved(httpcache.LastHTTPResponsesCacheKey)
+	if err != nil {
+		return nil, fmt.Errorf("missing HTTP(s) responses of concurrent requests, err: %w", err)
+	}
+
+	lastResponses, ok := responses.([]*http.Response)
+	if !ok {
+		return nil, fmt.Errorf("HTTP(s) responses of concurrent requests data structure is not type []*http.Response")
+	}
+
+	return lastResponses, nil
+}
+
+// GetLastResponseTiming returns timing breakdown of last HTTP(s) request.
+func (apiCtx *APIContext) GetLastResponseTiming() (httpctx.Timing, error) {
+	timing, err := apiCtx.Cache.GetSaved(httpcache.LastHTTPResponseTimingCacheKey)
+	if err != nil {
+		return httpctx.Timing{}, fmt.Errorf("missing timing of last HTTP(s) request, err: %w", err)
+	}
+
+	lastTiming, ok := timing.(httpctx.Timing)
+	if !ok {
+		return httpctx.Timing{}, fmt.Errorf("timing of last HTTP(s) request data structure is not type httpctx.Timing")
+	}
+
+	return lastTiming, nil
+}
+
+// GetLastResponseBody returns last HTTP(s) response body.
+// Body read into memory is replaced with new NopCloser and body written to file is rewound,
+// so this method is safe to reuse many times. Body written to file is read into memory on every call,
+// unless it is larger than ResponseBodyOptions.MaxInMemorySize, then httpctx.BodyTooLargeError is returned.
+func (apiCtx *APIContext) GetLastResponseBody() ([]byte, error) {
+	lastResponse, err := apiCtx.GetLastResponse()
+	if err != nil {
+		return []byte(""), fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+	}
+
+	var bodyBytes []byte
+
+	if lastResponse != nil && lastResponse.Body != nil {
+		// body written to file is loaded only up to limit and rewound on close, so it may be read again
+		if fileBody, ok := lastResponse.Body.(*httpctx.FileBody); ok {
+			limit := apiCtx.ResponseBodyOptions.InMemoryLimit()
+			if fileBody.Size > limit {
+				return nil, &httpctx.BodyTooLargeError{MaxSize: limit}
 			}
 
-			if !matched {
-				return fmt.Errorf("%s does not contain any: %s", cookie.Value, regExp)
-			}
+			bodyBytes, _ = ioutil.ReadAll(io.LimitReader(fileBody, limit))
+			fileBody.Close()
+
+			return bodyBytes, nil
+		}
+
+		bodyBytes, _ = ioutil.ReadAll(lastResponse.Body)
+		lastResponse.Body.Close()
+		lastResponse.Body = ioutil.NopCloser(bytes.NewBuffer(bodyBytes))
+	}
+
+	return bodyBytes, nil
+}
+
+// iValidateNodeWithSchemaGeneral validates last response body node against schema as provided in reference.
+func (apiCtx *APIContext) iValidateNodeWithSchemaGeneral(dataFormat df.DataFormat, exprTemplate, referenceTemplate string, validator validator.SchemaValidator) error {
+	body, err := apiCtx.getNodeSource()
+	if err != nil {
+		return err
+	}
+
+	reference, err := apiCtx.TemplateEngine.Replace(referenceTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'reference' template, err: %w", err)
+	}
+
+	if apiCtx.Debugger.IsOn() {
+		apiCtx.Debugger.Print(fmt.Sprintf("'%s' was replaced as: '%s'\n", referenceTemplate, reference))
+	}
+
+	expr, err := apiCtx.TemplateEngine.Replace(exprTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'expression' template, err: %w", err)
+	}
+
+	var node any
+	switch dataFormat {
+	case df.JSON:
+		node, err = apiCtx.PathFinders.JSON.Find(expr, body)
+	case df.YAML:
+		node, err = apiCtx.PathFinders.YAML.Find(expr, body)
+	case df.XML:
+		return fmt.Errorf("this method does not support data in format: %s", df.XML)
+	default:
+		return fmt.Errorf("provided unknown format: %s, format should be one of : %s, %s, %s",
+			dataFormat, df.JSON, df.YAML, df.XML)
+	}
+
+	if err != nil {
+		return &NodeNotFoundError{Expression: expr, DataFormat: dataFormat, Err: err}
+	}
+
+	jsonNode, err := apiCtx.Serializers.JSON.Serialize(node)
+	if err != nil {
+		return fmt.Errorf("problem during formatting data to JSON, err: %w", err)
+	}
+
+	if apiCtx.Debugger.IsOn() {
+		apiCtx.Debugger.Print(fmt.Sprintf("%+v\n\n was marshaled to:\n\n %s \n\nand passed for validation", node, jsonNode))
+	}
+
+	return schemaValidationError(expr, dataFormat, validator.Validate(string(jsonNode), reference))
+}
+
+// generateFromSchemaGeneral generates data valid against schema as provided in schemaTemplate and saves it under cacheKey.
+func (apiCtx *APIContext) generateFromSchemaGeneral(schemaTemplate string, mode schema.GenerationMode, cacheKey string, generator schemaGenerator) error {
+	if len(cacheKey) == 0 {
+		return fmt.Errorf("cacheKey should not be empty value")
+	}
+
+	source, err := apiCtx.TemplateEngine.Replace(schemaTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'schema' template, err: %w", err)
+	}
+
+	data, err := generator.Generate(source, mode)
+	if err != nil {
+		return fmt.Errorf("could not generate data from schema, err: %w", err)
+	}
+
+	jsonData, err := apiCtx.Serializers.JSON.Serialize(data)
+	if err != nil {
+		return fmt.Errorf("problem during formatting data to JSON, err: %w", err)
+	}
+
+	if apiCtx.Debugger.IsOn() {
+		apiCtx.Debugger.Print(fmt.Sprintf("generated data saved under '%s':\n\n%s", cacheKey, jsonData))
+	}
+
+	apiCtx.Cache.Save(cacheKey, string(jsonData))
+
+	return nil
+}
+
+// assertRequestRejectsSchemaViolationsGeneral sends previously prepared request with invalid variants of body
+// and checks whether each of them was answered with statusCode.
+func (apiCtx *APIContext) assertRequestRejectsSchemaViolationsGeneral(cacheKey, bodyTemplate, schemaTemplate string, statusCode int, generator schemaGenerator, validator validator.SchemaValidator) error {
+	req, err := apiCtx.GetPreparedRequest(cacheKey)
+	if err != nil {
+		return fmt.Errorf("could not obtain prepared request, err: %w", err)
+	}
+
+	body, err := apiCtx.TemplateEngine.Replace(bodyTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'body' template, err: %w", err)
+	}
+
+	source, err := apiCtx.TemplateEngine.Replace(schemaTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'schema' template, err: %w", err)
+	}
+
+	bodyBytes := []byte(body)
+	if !df.IsJSON(bodyBytes) {
+		return fmt.Errorf("this method supports only data in format: %s", df.JSON)
+	}
+
+	if err = validator.Validate(body, source); err != nil {
+		return fmt.Errorf("base body should be valid against schema, err: %w", err)
+	}
+
+	var document any
+	if err = apiCtx.Serializers.JSON.Deserialize(bodyBytes, &document); err != nil {
+		return fmt.Errorf("could not deserialize provided body, err: %w", err)
+	}
+
+	mutations, err := generator.GenerateInvalid(source, document)
+	if err != nil {
+		return fmt.Errorf("could not generate invalid variants of body, err: %w", err)
+	}
+
+	accepted := make([]string, 0, len(mutations))
+	sent := 0
+	for _, mutation := range mutations {
+		variant, err := apiCtx.Serializers.JSON.Serialize(mutation.Document)
+		if err != nil {
+			return fmt.Errorf("problem during formatting data to JSON, err: %w", err)
+		}
+
+		// some mutations may still be valid, for example when schema allows value in other anyOf/oneOf branch
+		if validator.Validate(string(variant), source) == nil {
+			continue
+		}
+
+		if apiCtx.Debugger.IsOn() {
+			apiCtx.Debugger.Print(fmt.Sprintf("sending invalid variant of body - %s", mutation.Description))
+		}
+
+		resp, err := apiCtx.sendRequest(cloneRequestWithBody(req, variant))
+		if err != nil {
+			return err
+		}
+
+		sent++
+		if resp.StatusCode != statusCode {
+			accepted = append(accepted, fmt.Sprintf("%s - got status code %d, body: %s", mutation.Description, resp.StatusCode, variant))
+		}
+	}
+
+	if sent == 0 {
+		return fmt.Errorf("could not generate any invalid variant of body against provided schema")
+	}
+
+	if len(accepted) > 0 {
+		return &AssertionError{Expression: StatusCodeExpression, Expected: statusCode,
+			Err: fmt.Errorf("%d of %d invalid variants of body were not answered with status code %d:\n%s", len(accepted), sent, statusCode, strings.Join(accepted, "\n"))}
+	}
+
+	return nil
+}
+
+// sendRequest sends HTTP(s) request and saves obtained response as last HTTP(s) response.
+func (apiCtx *APIContext) sendRequest(req *http.Request) (*http.Response, error) {
+	if apiCtx.Debugger.IsOn() {
+		command, _ := http2curl.GetCurlCommand(req)
+		apiCtx.Debugger.Print(command.String())
+	}
+
+	apiCtx.Cache.Save(httpcache.LastHTTPRequestTimestamp, time.Now())
+
+	tracedReq, tracer := httpctx.TraceTiming(req)
+	resp, err := apiCtx.RequestDoer.Do(tracedReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request %s %s, reason: %w", req.Method, req.URL.String(), err)
+	}
+
+	apiCtx.Cache.Save(httpcache.LastHTTPResponseTimestamp, time.Now())
+
+	// body is read here, so time of its download is part of timing and its size is limited
+	if resp.Body != nil {
+		respBody, err := httpctx.ReadBody(resp.Body, apiCtx.ResponseBodyOptions)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("could not read response body of request %s %s, err: %w", req.Method, req.URL.String(), err)
+		}
+
+		resp.Body = respBody
+	}
+
+	tracer.Done()
+	timing := tracer.Timing()
+	apiCtx.Cache.Save(httpcache.LastHTTPResponseTimingCacheKey, timing)
+	apiCtx.saveLastResponse(resp)
+
+	if apiCtx.Debugger.IsOn() {
+		apiCtx.Debugger.Print(fmt.Sprintf("%s %s (%d)", req.Method, req.URL.String(), resp.StatusCode))
+		apiCtx.Debugger.Print(fmt.Sprintf("timing: %s", timing))
+		if fileBody, ok := resp.Body.(*httpctx.FileBody); ok {
+			apiCtx.Debugger.Print(fmt.Sprintf("response body of %d bytes saved in file: %s", fileBody.Size, fileBody.Path))
+		} else {
+			respBody, _ := apiCtx.GetLastResponseBody()
+			apiCtx.Debugger.Print(string(respBody))
+		}
+	}
+
+	return resp, nil
+}
+
+// requestSendConditional sends copy of previously prepared request with conditionHeader equal to validatorHeader
+// of last HTTP(s) response.
+func (apiCtx *APIContext) requestSendConditional(cacheKey, conditionHeader, validatorHeader string) error {
+	req, err := apiCtx.GetPreparedRequest(cacheKey)
+	if err != nil {
+		return fmt.Errorf("could not obtain prepared request, err: %w", err)
+	}
+
+	lastResp, err := apiCtx.GetLastResponse()
+	if err != nil {
+		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
+	}
+
+	validatorValue := lastResp.Header.Get(validatorHeader)
+	if validatorValue == "" {
+		return fmt.Errorf("last HTTP(s) response does not have header with name '%s'", validatorHeader)
+	}
+
+	conditionalReq, err := clonePreparedRequest(req)
+	if err != nil {
+		return err
+	}
+
+	conditionalReq.Header.Set(conditionHeader, validatorValue)
+	_, err = apiCtx.sendRequest(conditionalReq)
+
+	return err
+}
+
+// requestSendPaginatedGeneral sends previously prepared request and next pages pointed by nextPage, which returns
+// nil URL when there are no more pages. Elements of node obtained using itemsExprTemplate from every page are saved
+// as one array under itemsCacheKey and replace last HTTP(s) response body.
+func (apiCtx *APIContext) requestSendPaginatedGeneral(cacheKey string, dataFormat df.DataFormat, itemsExprTemplate string, maxPages int, itemsCacheKey string,
+	nextPage func(req *http.Request, resp *http.Response, body []byte, items []any) (*url.URL, error)) error {
+	if dataFormat != df.JSON && dataFormat != df.YAML {
+		return fmt.Errorf("this method does not support data in format: %s", dataFormat)
+	}
+
+	itemsExpr, err := apiCtx.TemplateEngine.Replace(itemsExprTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'items expression' template, err: %w", err)
+	}
+
+	req, err := apiCtx.GetPreparedRequest(cacheKey)
+	if err != nil {
+		return fmt.Errorf("could not obtain prepared request, err: %w", err)
+	}
+
+	pageReq, err := clonePreparedRequest(req)
+	if err != nil {
+		return err
+	}
+
+	items := make([]any, 0)
+	visited := map[string]bool{pageReq.URL.String(): true}
+	var resp *http.Response
+	for page := 1; ; page++ {
+		if resp, err = apiCtx.sendRequest(pageReq); err != nil {
+			return fmt.Errorf("could not fetch page %d, err: %w", page, err)
+		}
+
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return fmt.Errorf("page %d: %s %s responded with status code %d, %s", page, pageReq.Method, pageReq.URL, resp.StatusCode, apiCtx.lastResponseBodySnippet())
+		}
+
+		body, err := apiCtx.GetLastResponseBody()
+		if err != nil {
+			return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+		}
 
-			return nil
+		pageItems, err := apiCtx.getNodeElements(body, itemsExpr, dataFormat)
+		if err != nil {
+			return fmt.Errorf("page %d: %w", page, err)
+		}
+
+		items = append(items, pageItems...)
+		if maxPages > 0 && page >= maxPages {
+			break
+		}
+
+		nextURL, err := nextPage(pageReq, resp, body, pageItems)
+		if err != nil {
+			return fmt.Errorf("could not obtain URL of page %d, err: %w", page+1, err)
+		}
+
+		if nextURL == nil {
+			break
+		}
+
+		if visited[nextURL.String()] {
+			return fmt.Errorf("page %d points at already fetched page %s", page, nextURL)
 		}
+
+		visited[nextURL.String()] = true
+		if pageReq, err = clonePreparedRequest(req); err != nil {
+			return err
+		}
+
+		pageReq.URL = nextURL
+		pageReq.Host = ""
 	}
 
-	return fmt.Errorf("last HTTP(s) response does not have cookie with name '%s'", name)
+	if apiCtx.Debugger.IsOn() {
+		apiCtx.Debugger.Print(fmt.Sprintf("collected %d items from paginated responses", len(items)))
+	}
+
+	apiCtx.Cache.Save(itemsCacheKey, items)
+
+	return nil
 }
 
-// AssertResponseCookieValueNotMatchesRegExp checks whether last HTTP(s) response has cookie of given name and value
-// is not matching provided regExp.
-func (apiCtx *APIContext) AssertResponseCookieValueNotMatchesRegExp(name, regExpTemplate string) error {
+// withQueryParam returns copy of u with query parameter name set to value.
+func withQueryParam(u *url.URL, name, value string) *url.URL {
+	clone := *u
+	query := clone.Query()
+	query.Set(name, value)
+	clone.RawQuery = query.Encode()
+
+	return &clone
+}
+
+// queryParamInt returns value of integer query parameter of u or defaultValue, when parameter is not present.
+func queryParamInt(u *url.URL, name string, defaultValue int) (int, error) {
+	value := u.Query().Get(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("query parameter '%s' should be integer, got '%s'", name, value)
+	}
+
+	return number, nil
+}
+
+// readPreparedRequestBody returns body of previously prepared request and makes it possible to read it again.
+func readPreparedRequestBody(req *http.Request) ([]byte, error) {
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("could not obtain prepared request body, err: %w", err)
+		}
+
+		defer body.Close()
+
+		return ioutil.ReadAll(body)
+	}
+
+	if req.Body == nil {
+		return nil, nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not read prepared request body, err: %w", err)
+	}
+
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return body, nil
+}
+
+// statusCodeCounts returns number of occurrences of every status code of responses.
+func statusCodeCounts(responses []*http.Response) map[int]int {
+	counts := make(map[int]int)
+	for _, resp := range responses {
+		counts[resp.StatusCode]++
+	}
+
+	return counts
+}
+
+// clonePreparedRequest returns copy of previously prepared request, that may be sent independently of it.
+func clonePreparedRequest(req *http.Request) (*http.Request, error) {
+	clone := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("could not obtain prepared request body, err: %w", err)
+		}
+
+		clone.Body = body
+	}
+
+	return clone, nil
+}
+
+// cloneRequestWithBody returns copy of req with provided body, that may be sent independently of req.
+func cloneRequestWithBody(req *http.Request, body []byte) *http.Request {
+	clone := req.Clone(req.Context())
+	clone.ContentLength = int64(len(body))
+	clone.Body = ioutil.NopCloser(bytes.NewReader(body))
+	clone.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return clone
+}
+
+// compareResponseBodyGeneral compares document from expectedTemplate with last HTTP(s) response body.
+func (apiCtx *APIContext) compareResponseBodyGeneral(dataFormat df.DataFormat, expectedTemplate string, options comparator.Options) ([]comparator.Difference, error) {
+	if dataFormat != df.JSON && dataFormat != df.YAML && dataFormat != df.XML {
+		return nil, fmt.Errorf("this method does not support data in format: %s", dataFormat)
+	}
+
+	expectedData, err := apiCtx.TemplateEngine.Replace(expectedTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return nil, fmt.Errorf("template engine has problem with 'expected' template, err: %w", err)
+	}
+
+	body, err := apiCtx.GetLastResponseBody()
+	if err != nil {
+		return nil, fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+	}
+
+	expected, err := comparator.Decode([]byte(expectedData), dataFormat)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode expected document, err: %w", err)
+	}
+
+	actual, err := comparator.Decode(body, dataFormat)
+	if err != nil {
+		return nil, &AssertionError{DataFormat: dataFormat, Err: fmt.Errorf("could not decode last HTTP(s) response body, err: %w", err)}
+	}
+
+	if apiCtx.Debugger.IsOn() {
+		apiCtx.Debugger.Print(fmt.Sprintf("comparing last HTTP(s) response body with expected document:\n\n%s", expectedData))
+	}
+
+	options.ScalarsAsStrings = dataFormat == df.XML
+
+	return comparator.Compare(expected, actual, options)
+}
+
+// splitList splits comma separated list into trimmed, not empty elements.
+func splitList(list string) []string {
+	elements := make([]string, 0)
+	for _, element := range strings.Split(list, ",") {
+		if element = strings.TrimSpace(element); element != "" {
+			elements = append(elements, element)
+		}
+	}
+
+	return elements
+}
+
+// getNodeTime returns time from last HTTP(s) response body node obtained using exprTemplate and replaced expression.
+func (apiCtx *APIContext) getNodeTime(dataFormat df.DataFormat, exprTemplate, layout string) (time.Time, string, error) {
+	expr, err := apiCtx.TemplateEngine.Replace(exprTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("template engine has problem with 'expression' template, err: %w", err)
+	}
+
+	body, err := apiCtx.getNodeSource()
+	if err != nil {
+		return time.Time{}, expr, err
+	}
+
+	iValue, err := apiCtx.getNode(body, expr, dataFormat, types.Any)
+	if err != nil {
+		return time.Time{}, expr, err
+	}
+
+	nodeTime, err := parseNodeTime(iValue, dataFormat, expr, layout)
+	if err != nil {
+		return time.Time{}, expr, &AssertionError{Expression: expr, DataFormat: dataFormat, Actual: iValue, Err: err}
+	}
+
+	if apiCtx.Debugger.IsOn() {
+		apiCtx.Debugger.Print(fmt.Sprintf("node '%s' value '%v' was parsed as time: %s", expr, iValue, nodeTime.Format(time.RFC3339Nano)))
+	}
+
+	return nodeTime, expr, nil
+}
+
+// parseNodeTime parses node value being string or number (unix timestamp) as time using layout.
+func parseNodeTime(nodeValue any, dataFormat df.DataFormat, expr, layout string) (time.Time, error) {
+	value, ok := nodeValue.(string)
+	if !ok {
+		number, err := nodeToFloat64(nodeValue, dataFormat, expr)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("expected node '%s' to be string or number, got '%v'", expr, nodeValue)
+		}
+
+		value = strconv.FormatFloat(number, 'f', -1, 64)
+	}
+
+	nodeTime, err := timeutils.Parse(value, layout)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("node '%s' could not be parsed as time, err: %w", expr, err)
+	}
+
+	return nodeTime, nil
+}
+
+// getCachedTime returns time.Time saved in cache under cacheKey.
+func (apiCtx *APIContext) getCachedTime(cacheKey string) (time.Time, error) {
+	iValue, err := apiCtx.Cache.GetSaved(cacheKey)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("could not obtain %s from cache, err: %w", cacheKey, err)
+	}
+
+	cachedTime, ok := iValue.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("value under key %s in cache doesn't contain time.Time", cacheKey)
+	}
+
+	return cachedTime, nil
+}
+
+// getLastResponseHeaderValues returns all values of last HTTP(s) response header, including values of repeated header.
+func (apiCtx *APIContext) getLastResponseHeaderValues(name string) ([]string, error) {
 	lastResp, err := apiCtx.GetLastResponse()
 	if err != nil {
-		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
+		return nil, fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
 	}
 
-	defer func() {
-		if apiCtx.Debugger.IsOn() {
-			apiCtx.Debugger.Print(fmt.Sprintf("last HTTP(s) response cookies: %#v", lastResp.Cookies()))
+	if apiCtx.Debugger.IsOn() {
+		apiCtx.Debugger.Print(fmt.Sprintf("last HTTP(s) response headers: %#v", lastResp.Header))
+	}
+
+	values := lastResp.Header.Values(name)
+	if len(values) == 0 {
+		return nil, &AssertionError{Expression: name, Err: fmt.Errorf("could not find header '%s' in last HTTP(s) response", name)}
+	}
+
+	return values, nil
+}
+
+// getLastResponseCacheControl returns directive from directiveTemplate together with directives
+// of last HTTP(s) response Cache-Control header.
+func (apiCtx *APIContext) getLastResponseCacheControl(directiveTemplate string) (string, map[string]string, error) {
+	directive, err := apiCtx.TemplateEngine.Replace(directiveTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return "", nil, fmt.Errorf("template engine has problem with 'directive' template, err: %w", err)
+	}
+
+	lastResp, err := apiCtx.GetLastResponse()
+	if err != nil {
+		return directive, nil, fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
+	}
+
+	if apiCtx.Debugger.IsOn() {
+		apiCtx.Debugger.Print(fmt.Sprintf("last HTTP(s) response Cache-Control header: %q", lastResp.Header.Values("Cache-Control")))
+	}
+
+	return directive, httpctx.ParseCacheControl(lastResp.Header.Values("Cache-Control")), nil
+}
+
+// saveJWT decodes JSON Web Token and saves it under given cache key.
+func (apiCtx *APIContext) saveJWT(raw, cacheKey string) error {
+	token, err := jwt.Decode(raw)
+	if err != nil {
+		return fmt.Errorf("could not decode JSON Web Token, err: %w", err)
+	}
+
+	if apiCtx.Debugger.IsOn() {
+		apiCtx.Debugger.Print(fmt.Sprintf("JSON Web Token header: %v, claims: %v", token.Header, token.Claims))
+	}
+
+	apiCtx.Cache.Save(cacheKey, token)
+
+	return nil
+}
+
+// getJWT returns JSON Web Token saved under given cache key.
+func (apiCtx *APIContext) getJWT(cacheKey string) (jwt.Token, error) {
+	value, err := apiCtx.Cache.GetSaved(cacheKey)
+	if err != nil {
+		return jwt.Token{}, fmt.Errorf("could not obtain %s from cache, err: %w", cacheKey, err)
+	}
+
+	token, ok := value.(jwt.Token)
+	if !ok {
+		return jwt.Token{}, fmt.Errorf("value saved in cache under key '%s' is not JSON Web Token, got %T", cacheKey, value)
+	}
+
+	return token, nil
+}
+
+// jwtSignatureError describes failed verification of signature of JSON Web Token saved under cacheKey.
+// Only signature not matching key is assertion mismatch, problems with key or algorithm are returned as they are.
+func jwtSignatureError(cacheKey string, err error) error {
+	err = fmt.Errorf("could not verify signature of token saved under key '%s', err: %w", cacheKey, err)
+	if errors.Is(err, jwt.ErrInvalidSignature) {
+		return &AssertionError{Err: err}
+	}
+
+	return err
+}
+
+// schemaValidationError marks document not valid against schema as assertion mismatch of node pointed by expr,
+// problems with loading document or schema are returned as they are.
+func schemaValidationError(expr string, dataFormat df.DataFormat, err error) error {
+	var violationErr *validator.ViolationError
+	if errors.As(err, &violationErr) {
+		return &AssertionError{Expression: expr, DataFormat: dataFormat, Err: err}
+	}
+
+	return err
+}
+
+// getNodeSource returns document from which node assertions read nodes: last HTTP(s) response body
+// or document saved in cache and set with SetCachedDocumentAsNodeSource.
+func (apiCtx *APIContext) getNodeSource() ([]byte, error) {
+	if apiCtx.nodeSourceCacheKey != "" {
+		return apiCtx.getCachedDocument(apiCtx.nodeSourceCacheKey)
+	}
+
+	body, err := apiCtx.GetLastResponseBody()
+	if err != nil {
+		return nil, fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+	}
+
+	return body, nil
+}
+
+// getCachedDocument returns document saved in cache under cacheKey. Values other than string and []byte
+// are serialized to JSON.
+func (apiCtx *APIContext) getCachedDocument(cacheKey string) ([]byte, error) {
+	value, err := apiCtx.Cache.GetSaved(cacheKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not obtain %s from cache, err: %w", cacheKey, err)
+	}
+
+	switch document := value.(type) {
+	case []byte:
+		return document, nil
+	case string:
+		return []byte(document), nil
+	default:
+		data, err := apiCtx.Serializers.JSON.Serialize(document)
+		if err != nil {
+			return nil, fmt.Errorf("could not serialize value saved in cache under key '%s' to JSON, err: %w", cacheKey, err)
 		}
-	}()
 
-	regExp, err := apiCtx.TemplateEngine.Replace(regExpTemplate, apiCtx.Cache.All())
-	if err != nil {
-		return fmt.Errorf("template engine has problem with 'regExp' template, err: %w", err)
+		return data, nil
+	}
+}
+
+// getLoadSummary returns summary of requests sent with RequestSendWithLoadProfile saved in cache under cacheKey.
+func (apiCtx *APIContext) getLoadSummary(cacheKey string) (load.Summary, error) {
+	value, err := apiCtx.Cache.GetSaved(cacheKey)
+	if err != nil {
+		return load.Summary{}, fmt.Errorf("could not obtain %s from cache, err: %w", cacheKey, err)
+	}
+
+	summary, ok := value.(load.Summary)
+	if !ok {
+		return load.Summary{}, fmt.Errorf("value saved in cache under key '%s' is not load summary, got %T", cacheKey, value)
+	}
+
+	return summary, nil
+}
+
+// saveLastResponse saves resp as last HTTP(s) response. Temporary file of previous last response body is deleted.
+func (apiCtx *APIContext) saveLastResponse(resp *http.Response) {
+	if lastResp, err := apiCtx.GetLastResponse(); err == nil && lastResp != resp && lastResp.Body != resp.Body {
+		apiCtx.removeLastResponseBodyFile()
+	}
+
+	apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, resp)
+}
+
+// removeLastResponseBodyFile deletes temporary file of last HTTP(s) response body, if body was written to file.
+func (apiCtx *APIContext) removeLastResponseBodyFile() {
+	lastResp, err := apiCtx.GetLastResponse()
+	if err != nil || lastResp == nil {
+		return
+	}
+
+	if fileBody, ok := lastResp.Body.(*httpctx.FileBody); ok {
+		fileBody.Remove()
+	}
+}
+
+// getLastResponseBodySize returns number of bytes of last HTTP(s) response body.
+func (apiCtx *APIContext) getLastResponseBodySize() (int64, error) {
+	lastResp, err := apiCtx.GetLastResponse()
+	if err != nil {
+		return 0, fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
+	}
+
+	if fileBody, ok := lastResp.Body.(*httpctx.FileBody); ok {
+		return fileBody.Size, nil
+	}
+
+	body, err := apiCtx.GetLastResponseBody()
+	if err != nil {
+		return 0, fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+	}
+
+	return int64(len(body)), nil
+}
+
+// getLastResponseCookie returns last HTTP(s) response cookie of given name.
+func (apiCtx *APIContext) getLastResponseCookie(name string) (*http.Cookie, error) {
+	lastResp, err := apiCtx.GetLastResponse()
+	if err != nil {
+		return nil, fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
+	}
+
+	if apiCtx.Debugger.IsOn() {
+		apiCtx.Debugger.Print(fmt.Sprintf("last HTTP(s) response cookies: %+v", lastResp.Cookies()))
+	}
+
+	for _, cookie := range lastResp.Cookies() {
+		if cookie.Name == name {
+			return cookie, nil
+		}
+	}
+
+	return nil, &AssertionError{Expression: name, Err: fmt.Errorf("last HTTP(s) response does not have cookie with name '%s'", name)}
+}
+
+// lastResponseBodySnippet describes last HTTP(s) response body, limited to debugger's bytes limit, for use in error messages.
+func (apiCtx *APIContext) lastResponseBodySnippet() string {
+	lastResponse, err := apiCtx.GetLastResponse()
+	if err != nil || lastResponse == nil || lastResponse.Body == nil {
+		return "response body is empty"
+	}
+
+	limit := defaultBodySnippetLimit
+	if limiter, ok := apiCtx.Debugger.(bytesLimiter); ok {
+		limit = int(limiter.Limit())
+	}
+
+	size := lastResponse.ContentLength
+	body := lastResponse.Body
+	snippet, _ := ioutil.ReadAll(io.LimitReader(body, int64(limit)+1))
+	if fileBody, ok := body.(*httpctx.FileBody); ok {
+		size = fileBody.Size
+		fileBody.Close()
+	} else {
+		// bytes already read are put back in front of the rest of body, so it may be read again
+		lastResponse.Body = struct {
+			io.Reader
+			io.Closer
+		}{Reader: io.MultiReader(bytes.NewReader(snippet), body), Closer: body}
+	}
+
+	if len(snippet) == 0 {
+		return "response body is empty"
+	}
+
+	if len(snippet) <= limit {
+		return fmt.Sprintf("response body: %s", snippet)
+	}
+
+	if size <= int64(limit) {
+		return fmt.Sprintf("response body (first %d bytes): %s", limit, snippet[:limit])
 	}
 
-	if apiCtx.Debugger.IsOn() {
-		apiCtx.Debugger.Print(fmt.Sprintf("provided regExp template: '%s' was replace to: '%s'", regExpTemplate, regExp))
+	return fmt.Sprintf("response body (first %d of %d bytes): %s", limit, size, snippet[:limit])
+}
+
+// completeAssertion wraps error pointed by err returned by assertion into AssertionError describing assertion
+// and, when soft assertion mode is on, records it and clears err. It should be deferred by every Assert* method.
+func (apiCtx *APIContext) completeAssertion(err *error, assertion string, args ...any) {
+	if *err == nil {
+		return
 	}
 
-	for _, cookie := range lastResp.Cookies() {
-		if cookie.Name == name {
-			matched, err := regexp.Match(regExp, []byte(cookie.Value))
-			if err != nil {
-				return fmt.Errorf("problem with regExp matching, err: %w", err)
-			}
+	// only mismatches between expected and actual state may be softened, setup and infrastructure
+	// failures like missing last response or cache miss always interrupt scenario.
+	assertionErr, isMismatch := (*err).(*AssertionError)
+	if !isMismatch {
+		assertionErr = &AssertionError{Err: *err}
+	}
 
-			if !matched {
-				return nil
-			}
+	assertionErr.Assertion = assertion
+	assertionErr.Arguments = args
 
-			return fmt.Errorf("%s contains some: %s, but expected not to", cookie.Value, regExp)
+	var typeErr *TypeMismatchError
+	var notFoundErr *NodeNotFoundError
+	if errors.As(assertionErr.Err, &typeErr) {
+		isMismatch = true
+		if assertionErr.Expression == "" {
+			assertionErr.Expression, assertionErr.DataFormat = typeErr.Expression, typeErr.DataFormat
+			assertionErr.Expected, assertionErr.Actual = typeErr.ExpectedType, typeErr.ActualType
+		}
+	} else if errors.As(assertionErr.Err, &notFoundErr) {
+		isMismatch = true
+		if assertionErr.Expression == "" {
+			assertionErr.Expression, assertionErr.DataFormat = notFoundErr.Expression, notFoundErr.DataFormat
 		}
 	}
 
-	return fmt.Errorf("last HTTP(s) response does not have cookie with name '%s'", name)
-}
+	*err = assertionErr
 
-// Save saves into cache arbitrary passed data.
-func (apiCtx *APIContext) Save(valueTemplate, cacheKey string) error {
-	if len(valueTemplate) == 0 {
-		return fmt.Errorf("pass any value")
+	if !apiCtx.isSoftAssertionMode || !isMismatch {
+		return
 	}
 
-	if len(cacheKey) == 0 {
-		return fmt.Errorf("cacheKey should not be empty value")
+	formattedArgs := make([]string, 0, len(args))
+	for _, arg := range args {
+		formattedArgs = append(formattedArgs, fmt.Sprintf("%v", arg))
 	}
 
-	value, err := apiCtx.TemplateEngine.Replace(valueTemplate, apiCtx.Cache.All())
-	if err != nil {
-		return fmt.Errorf("template engine has problem with 'value' template, err: %w", err)
-	}
+	failure := SoftAssertionFailure{Assertion: fmt.Sprintf("%s(%s)", assertion, strings.Join(formattedArgs, ", ")), Err: assertionErr}
+	apiCtx.softAssertionFailures = append(apiCtx.softAssertionFailures, failure)
 
-	apiCtx.Cache.Save(cacheKey, value)
+	if apiCtx.Debugger.IsOn() {
+		apiCtx.Debugger.Print(fmt.Sprintf("soft assertion %s failed, err: %s", failure.Assertion, failure.Err))
+	}
 
-	return nil
+	*err = nil
 }
 
-// SaveNode saves from last response body node under given cache key.
-// expr should be valid according to injected PathResolver of given data type
-func (apiCtx *APIContext) SaveNode(dataFormat df.DataFormat, exprTemplate, cacheKey string) error {
-	body, err := apiCtx.GetLastResponseBody()
+// compareNodeWithCachedGeneral returns differences between value saved in cache under cacheKey and
+// last HTTP(s) response body node obtained using exprTemplate, together with resolved expression.
+func (apiCtx *APIContext) compareNodeWithCachedGeneral(dataFormat df.DataFormat, exprTemplate, cacheKey string) (string, []comparator.Difference, error) {
+	expr, err := apiCtx.TemplateEngine.Replace(exprTemplate, apiCtx.Cache.All())
 	if err != nil {
-		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+		return "", nil, fmt.Errorf("template engine has problem with 'expression' template, err: %w", err)
 	}
 
-	expr, err := apiCtx.TemplateEngine.Replace(exprTemplate, apiCtx.Cache.All())
+	cached, err := apiCtx.Cache.GetSaved(cacheKey)
 	if err != nil {
-		return fmt.Errorf("template engine has problem with 'expression' template, err: %w", err)
+		return expr, nil, fmt.Errorf("could not obtain %s from cache, err: %w", cacheKey, err)
 	}
 
-	iVal, err := apiCtx.getNode(body, expr, dataFormat, types.Any)
+	body, err := apiCtx.getNodeSource()
 	if err != nil {
-		if apiCtx.Debugger.IsOn() {
-			apiCtx.Debugger.Print(fmt.Sprintf("last response body:\n\n"))
-			apiCtx.Debugger.Print(string(body))
-		}
-
-		return err
+		return expr, nil, err
 	}
 
-	apiCtx.Cache.Save(cacheKey, iVal)
+	iValue, err := apiCtx.getNode(body, expr, dataFormat, types.Any)
+	if err != nil {
+		return expr, nil, err
+	}
 
-	return nil
-}
+	actual, err := comparator.Normalize(iValue)
+	if err != nil {
+		return expr, nil, fmt.Errorf("node '%s' could not be compared, err: %w", expr, err)
+	}
 
-// SaveHeader saves from last response header value under given cache key
-func (apiCtx *APIContext) SaveHeader(name, cacheKey string) error {
-	defer func() {
-		if apiCtx.Debugger.IsOn() {
-			lastResp, err := apiCtx.GetLastResponse()
-			if err != nil {
-				apiCtx.Debugger.Print("could not obtain last response headers")
-			}
+	expected, err := comparator.Normalize(cached)
+	if err != nil {
+		return expr, nil, fmt.Errorf("value saved in cache under key '%s' could not be compared, err: %w", cacheKey, err)
+	}
 
-			apiCtx.Debugger.Print(fmt.Sprintf("last HTTP(s) response headers: %#v", lastResp.Header))
-		}
-	}()
+	options := comparator.Options{
+		IgnoreArrayOrder: apiCtx.ComparisonOptions.IgnoreArrayOrder,
+		NumericTolerance: apiCtx.ComparisonOptions.NumericTolerance,
+	}
 
-	lastResp, err := apiCtx.GetLastResponse()
+	differences, err := comparator.Compare(expected, actual, options)
 	if err != nil {
-		return fmt.Errorf("could not obtain last HTTP(s) response, err: %w", err)
+		return expr, nil, err
 	}
 
-	header := lastResp.Header.Get(name)
-	if header == "" {
-		return fmt.Errorf("could not find header %s in last HTTP(s) response", name)
+	if apiCtx.Debugger.IsOn() {
+		apiCtx.Debugger.Print(fmt.Sprintf("node '%s' compared with value saved in cache under key '%s', found %d differences", expr, cacheKey, len(differences)))
 	}
 
-	apiCtx.Cache.Save(cacheKey, header)
-
-	return nil
+	return expr, differences, nil
 }
 
-// Wait waits for given timeInterval amount of time
-func (apiCtx *APIContext) Wait(timeInterval time.Duration) error {
-	time.Sleep(timeInterval)
-
-	return nil
-}
+// assertNodeElementsGeneral checks whether elements of array node obtained using exprTemplate satisfy quantifier,
+// element satisfies condition when node obtained from it using subExprTemplate matches.
+func (apiCtx *APIContext) assertNodeElementsGeneral(dataFormat df.DataFormat, exprTemplate string, quantifier collection.Quantifier, subExprTemplate, condition string, matches func(node any) bool) error {
+	expr, err := apiCtx.TemplateEngine.Replace(exprTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'expression' template, err: %w", err)
+	}
 
-// DebugPrintResponseBody prints last response from request.
-func (apiCtx *APIContext) DebugPrintResponseBody() error {
-	var tmp any
+	subExpr, err := apiCtx.TemplateEngine.Replace(subExprTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return fmt.Errorf("template engine has problem with 'sub expression' template, err: %w", err)
+	}
 
-	body, err := apiCtx.GetLastResponseBody()
+	body, err := apiCtx.getNodeSource()
 	if err != nil {
-		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+		return err
 	}
 
-	defer func() {
-		if err != nil {
-			apiCtx.Debugger.Print(string(body))
-		}
-	}()
+	elements, err := apiCtx.getNodeElements(body, expr, dataFormat)
+	if err != nil {
+		return err
+	}
 
-	if df.IsYAML(body) {
-		err = apiCtx.Serializers.YAML.Deserialize(body, &tmp)
+	matched := make([]bool, len(elements))
+	for i, element := range elements {
+		node, err := apiCtx.findInElement(element, subExpr, dataFormat)
 		if err != nil {
-			return nil
-		}
+			if apiCtx.Debugger.IsOn() {
+				apiCtx.Debugger.Print(fmt.Sprintf("element %d of node '%s' does not have node '%s', err: %s", i, expr, subExpr, err))
+			}
 
-		indentedRespBody, err := yaml.Marshal(tmp)
-		if err != nil {
-			return nil
+			continue
 		}
 
-		apiCtx.Debugger.Print(string(indentedRespBody))
-		return nil
+		matched[i] = matches(node)
 	}
 
-	if df.IsJSON(body) {
-		err = apiCtx.Serializers.JSON.Deserialize(body, &tmp)
-		if err != nil {
-			return nil
-		}
-
-		indentedRespBody, err := json.MarshalIndent(tmp, "", "\t")
-		if err != nil {
-			return nil
-		}
+	isSatisfied, offending, err := collection.Evaluate(quantifier, matched)
+	if err != nil {
+		return err
+	}
 
-		apiCtx.Debugger.Print(string(indentedRespBody))
+	if isSatisfied {
 		return nil
 	}
 
-	if df.IsXML(body) {
-		err = apiCtx.Serializers.XML.Deserialize(body, &tmp)
-		if err != nil {
-			return nil
-		}
-
-		indentedRespBody, err := xml.MarshalIndent(tmp, "", "\t")
-		if err != nil {
-			return nil
-		}
-
-		apiCtx.Debugger.Print(string(indentedRespBody))
+	subject := elementSubject(subExpr)
+	switch quantifier {
+	case collection.QuantifierAny:
+		return &AssertionError{Expression: expr, DataFormat: dataFormat,
+			Err: fmt.Errorf("none of %d elements of node '%s' satisfies condition: %s %s", len(elements), expr, subject, condition)}
+	case collection.QuantifierNone:
+		return &AssertionError{Expression: expr, DataFormat: dataFormat,
+			Err: fmt.Errorf("elements of node '%s' at indices %v satisfy condition: %s %s", expr, offending, subject, condition)}
+	default:
+		return &AssertionError{Expression: expr, DataFormat: dataFormat,
+			Err: fmt.Errorf("elements of node '%s' at indices %v do not satisfy condition: %s %s", expr, offending, subject, condition)}
 	}
-
-	apiCtx.Debugger.Print(string(body))
-	return nil
 }
 
-// DebugStart starts debugging mode
-func (apiCtx *APIContext) DebugStart() error {
-	apiCtx.Debugger.TurnOn()
-
-	return nil
-}
+// getNodeSubElements returns nodes obtained using subExprTemplate from each element of array node obtained using exprTemplate
+// together with resolved expressions. Available data formats are JSON and YAML.
+func (apiCtx *APIContext) getNodeSubElements(dataFormat df.DataFormat, exprTemplate, subExprTemplate string) (string, string, []any, error) {
+	expr, err := apiCtx.TemplateEngine.Replace(exprTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return "", "", nil, fmt.Errorf("template engine has problem with 'expression' template, err: %w", err)
+	}
 
-// DebugStop stops debugging mode
-func (apiCtx *APIContext) DebugStop() error {
-	apiCtx.Debugger.TurnOff()
+	subExpr, err := apiCtx.TemplateEngine.Replace(subExprTemplate, apiCtx.Cache.All())
+	if err != nil {
+		return expr, "", nil, fmt.Errorf("template engine has problem with 'sub expression' template, err: %w", err)
+	}
 
-	return nil
-}
+	body, err := apiCtx.getNodeSource()
+	if err != nil {
+		return expr, subExpr, nil, err
+	}
 
-// GetPreparedRequest returns prepared request from cache or error if failed
-func (apiCtx *APIContext) GetPreparedRequest(cacheKey string) (*http.Request, error) {
-	reqInterface, err := apiCtx.Cache.GetSaved(cacheKey)
+	elements, err := apiCtx.getNodeElements(body, expr, dataFormat)
 	if err != nil {
-		return &http.Request{}, fmt.Errorf("could not obtain %s from cache, err: %w", cacheKey, err)
+		return expr, subExpr, nil, err
 	}
 
-	req, ok := reqInterface.(*http.Request)
-	if !ok {
-		return &http.Request{}, fmt.Errorf("value under key %s in cache doesn't contain *http.Request", cacheKey)
+	nodes := make([]any, len(elements))
+	for i, element := range elements {
+		nodes[i], err = apiCtx.findInElement(element, subExpr, dataFormat)
+		if err != nil {
+			return expr, subExpr, nil, fmt.Errorf("element %d of node '%s' does not have node '%s', err: %w", i, expr, subExpr, err)
+		}
 	}
 
-	return req, nil
+	return expr, subExpr, nodes, nil
 }
 
-// GetLastResponse returns last HTTP(s) response.
-func (apiCtx *APIContext) GetLastResponse() (*http.Response, error) {
-	respInterface, err := apiCtx.Cache.GetSaved(httpcache.LastHTTPResponseCacheKey)
+// getNodeElements returns elements of array node obtained using expr. Available data formats are JSON and YAML.
+func (apiCtx *APIContext) getNodeElements(body []byte, expr string, dataFormat df.DataFormat) ([]any, error) {
+	if dataFormat != df.JSON && dataFormat != df.YAML {
+		return nil, fmt.Errorf("this method does not support data in format: %s", dataFormat)
+	}
+
+	iValue, err := apiCtx.getNode(body, expr, dataFormat, types.Any)
 	if err != nil {
-		return nil, fmt.Errorf("missing last HTTP(s) response, err: %s", err.Error())
+		return nil, fmt.Errorf("node '%s', err: %w", expr, err)
 	}
 
-	lastResp, ok := respInterface.(*http.Response)
-	if !ok {
-		return nil, fmt.Errorf("last HTTP(s) response data structure is not type *http.Response")
+	v := reflect.ValueOf(iValue)
+	if v.Kind() != reflect.Slice {
+		expectedType, actualType := types.Array, apiCtx.TypeMappers.JSON.Map(iValue)
+		if dataFormat == df.YAML {
+			expectedType, actualType = types.Sequence, apiCtx.TypeMappers.YAML.Map(iValue)
+		}
+
+		return nil, &TypeMismatchError{Expression: expr, DataFormat: dataFormat, ExpectedType: expectedType, ActualType: actualType,
+			ActualGoType: apiCtx.TypeMappers.GO.Map(iValue)}
 	}
 
-	if lastResp == nil {
-		return nil, fmt.Errorf("missing last HTTP(s) response")
+	elements := make([]any, v.Len())
+	for i := range elements {
+		elements[i] = v.Index(i).Interface()
 	}
 
-	return lastResp, nil
+	return elements, nil
 }
 
-// GetLastResponseBody returns last HTTP(s) response body.
-// internally method creates new NoPCloser on last response so this method is safe to reuse many times
-func (apiCtx *APIContext) GetLastResponseBody() ([]byte, error) {
-	lastResponse, err := apiCtx.GetLastResponse()
-	if err != nil {
-		return []byte(""), fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+// findInElement returns node obtained using subExpr from element, empty subExpr means element itself.
+func (apiCtx *APIContext) findInElement(element any, subExpr string, dataFormat df.DataFormat) (any, error) {
+	if subExpr == "" {
+		return element, nil
 	}
 
-	var bodyBytes []byte
+	switch dataFormat {
+	case df.JSON:
+		data, err := apiCtx.Serializers.JSON.Serialize(element)
+		if err != nil {
+			return nil, fmt.Errorf("problem during formatting data to JSON, err: %w", err)
+		}
 
-	if lastResponse != nil {
-		bodyBytes, _ = ioutil.ReadAll(lastResponse.Body)
-		defer lastResponse.Body.Close()
+		return apiCtx.PathFinders.JSON.Find(subExpr, data)
+	case df.YAML:
+		data, err := apiCtx.Serializers.YAML.Serialize(element)
+		if err != nil {
+			return nil, fmt.Errorf("problem during formatting data to YAML, err: %w", err)
+		}
 
-		// last response body may be read again
-		lastResponse.Body = ioutil.NopCloser(bytes.NewBuffer(bodyBytes))
+		return apiCtx.PathFinders.YAML.Find(subExpr, data)
+	default:
+		return nil, fmt.Errorf("this method does not support data in format: %s", dataFormat)
+	}
+}
+
+// elementSubject describes what is checked in array element in error messages.
+func elementSubject(subExpr string) string {
+	if subExpr == "" {
+		return "element"
 	}
 
-	return bodyBytes, nil
+	return fmt.Sprintf("element's node '%s'", subExpr)
 }
 
-// iValidateNodeWithSchemaGeneral validates last response body node against schema as provided in reference.
-func (apiCtx *APIContext) iValidateNodeWithSchemaGeneral(dataFormat df.DataFormat, exprTemplate, referenceTemplate string, validator validator.SchemaValidator) error {
-	body, err := apiCtx.GetLastResponseBody()
-	if err != nil {
-		return fmt.Errorf("could not obtain last HTTP(s) response body, err: %w", err)
+// nodeValueToString returns string node as it is and other nodes in JSON format, for example: 10, true, null.
+func (apiCtx *APIContext) nodeValueToString(node any) string {
+	if str, ok := node.(string); ok {
+		return str
 	}
 
-	reference, err := apiCtx.TemplateEngine.Replace(referenceTemplate, apiCtx.Cache.All())
+	data, err := apiCtx.Serializers.JSON.Serialize(node)
 	if err != nil {
-		return fmt.Errorf("template engine has problem with 'reference' template, err: %w", err)
+		return fmt.Sprintf("%v", node)
 	}
 
-	if apiCtx.Debugger.IsOn() {
-		apiCtx.Debugger.Print(fmt.Sprintf("'%s' was replaced as: '%s'\n", referenceTemplate, reference))
-	}
+	return string(data)
+}
 
-	expr, err := apiCtx.TemplateEngine.Replace(exprTemplate, apiCtx.Cache.All())
-	if err != nil {
-		return fmt.Errorf("template engine has problem with 'expression' template, err: %w", err)
+// isNodeOfType checks whether node is of dataType in terms of dataFormat or Go.
+func (apiCtx *APIContext) isNodeOfType(node any, dataFormat df.DataFormat, dataType types.DataType) bool {
+	if apiCtx.TypeMappers.GO.Map(node) == dataType {
+		return true
 	}
 
-	var node any
 	switch dataFormat {
 	case df.JSON:
-		node, err = apiCtx.PathFinders.JSON.Find(expr, body)
+		return apiCtx.TypeMappers.JSON.Map(node) == dataType
 	case df.YAML:
-		node, err = apiCtx.PathFinders.YAML.Find(expr, body)
-	case df.XML:
-		return fmt.Errorf("this method does not support data in format: %s", df.XML)
+		return apiCtx.TypeMappers.YAML.Map(node) == dataType
 	default:
-		return fmt.Errorf("provided unknown format: %s, format should be one of : %s, %s, %s",
-			dataFormat, df.JSON, df.YAML, df.XML)
-	}
-
-	if err != nil {
-		return err
-	}
-
-	jsonNode, err := apiCtx.Serializers.JSON.Serialize(node)
-	if err != nil {
-		return fmt.Errorf("problem during formatting data to JSON, err: %w", err)
-	}
-
-	if apiCtx.Debugger.IsOn() {
-		apiCtx.Debugger.Print(fmt.Sprintf("%+v\n\n was marshaled to:\n\n %s \n\nand passed for validation", node, jsonNode))
+		return false
 	}
-
-	return validator.Validate(string(jsonNode), reference)
 }
 
 // getNode returns node value, when dataFormat and dataType matches expectations.
 func (apiCtx *APIContext) getNode(body []byte, expr string, dataFormat df.DataFormat, dataType types.DataType) (any, error) {
 	if body == nil || len(body) == 0 {
-		return nil, fmt.Errorf("provided nil body")
+		return nil, &NodeNotFoundError{Expression: expr, DataFormat: dataFormat, Err: ErrEmptyBody}
 	}
 
 	var iValue any
@@ -1689,7 +4843,7 @@ func (apiCtx *APIContext) getNode(body []byte, expr string, dataFormat df.DataFo
 	case df.JSON:
 		iValue, err = apiCtx.PathFinders.JSON.Find(expr, body)
 		if err != nil {
-			return nil, fmt.Errorf("could not find node using provided expression: '%s', err: %w", expr, err)
+			return nil, &NodeNotFoundError{Expression: expr, DataFormat: dataFormat, Err: err}
 		}
 
 		if dataType == types.Any {
@@ -1697,7 +4851,7 @@ func (apiCtx *APIContext) getNode(body []byte, expr string, dataFormat df.DataFo
 		}
 
 		if !(dataType.IsValidJSONDataType() || dataType.IsValidGoDataType()) {
-			return nil, fmt.Errorf("%s is not any of JSON data types and is not any of Go Data types", dataType)
+			return nil, fmt.Errorf("%w: %s is not any of JSON data types and is not any of Go Data types", ErrUnsupportedDataType, dataType)
 		}
 
 		recognizedDataType := apiCtx.TypeMappers.JSON.Map(iValue)
@@ -1706,14 +4860,14 @@ func (apiCtx *APIContext) getNode(body []byte, expr string, dataFormat df.DataFo
 			recognizedDataType = apiCtx.TypeMappers.GO.Map(iValue)
 
 			if recognizedDataType != dataType {
-				return nil, fmt.Errorf("expected node '%s' to be '%s', but node value is detected as '%s' in terms of JSON and '%s' in terms of Go",
-					expr, dataType, tmpRecognizedDataType, recognizedDataType)
+				return nil, &TypeMismatchError{Expression: expr, DataFormat: dataFormat, ExpectedType: dataType, ActualType: tmpRecognizedDataType,
+					ActualGoType: recognizedDataType}
 			}
 		}
 	case df.YAML:
 		iValue, err = apiCtx.PathFinders.YAML.Find(expr, body)
 		if err != nil {
-			return nil, fmt.Errorf("could not find node using provided expression: '%s', err: %w", expr, err)
+			return nil, &NodeNotFoundError{Expression: expr, DataFormat: dataFormat, Err: err}
 		}
 
 		if dataType == types.Any {
@@ -1721,7 +4875,7 @@ func (apiCtx *APIContext) getNode(body []byte, expr string, dataFormat df.DataFo
 		}
 
 		if !(dataType.IsValidYAMLDataType() || dataType.IsValidGoDataType()) {
-			return nil, fmt.Errorf("%s is not any of YAML data types and is not any of Go Data types", dataType)
+			return nil, fmt.Errorf("%w: %s is not any of YAML data types and is not any of Go Data types", ErrUnsupportedDataType, dataType)
 		}
 
 		recognizedDataType := apiCtx.TypeMappers.YAML.Map(iValue)
@@ -1730,14 +4884,14 @@ func (apiCtx *APIContext) getNode(body []byte, expr string, dataFormat df.DataFo
 			recognizedDataType = apiCtx.TypeMappers.GO.Map(iValue)
 
 			if recognizedDataType != dataType {
-				return nil, fmt.Errorf("expected node '%s' to be '%s', but node value is detected as '%s' in terms of YAML and '%s' in terms of Go",
-					expr, dataType, tmpRecognizedDataType, recognizedDataType)
+				return nil, &TypeMismatchError{Expression: expr, DataFormat: dataFormat, ExpectedType: dataType, ActualType: tmpRecognizedDataType,
+					ActualGoType: recognizedDataType}
 			}
 		}
 	case df.XML:
 		iValue, err = apiCtx.PathFinders.XML.Find(expr, body)
 		if err != nil {
-			return nil, fmt.Errorf("node '%s', err: %s", expr, err.Error())
+			return nil, &NodeNotFoundError{Expression: expr, DataFormat: dataFormat, Err: err}
 		}
 
 		if dataType == types.Any {
@@ -1745,12 +4899,12 @@ func (apiCtx *APIContext) getNode(body []byte, expr string, dataFormat df.DataFo
 		}
 
 		if !(dataType.IsValidXMLDataType() || dataType.IsValidGoDataType()) {
-			return nil, fmt.Errorf("%s is not any of XML data types and is not any of Go Data types", dataType)
+			return nil, fmt.Errorf("%w: %s is not any of XML data types and is not any of Go Data types", ErrUnsupportedDataType, dataType)
 		}
 	case df.HTML:
 		iValue, err = apiCtx.PathFinders.HTML.Find(expr, body)
 		if err != nil {
-			return nil, fmt.Errorf("node '%s', err: %s", expr, err.Error())
+			return nil, &NodeNotFoundError{Expression: expr, DataFormat: dataFormat, Err: err}
 		}
 
 		if dataType == types.Any {
@@ -1758,11 +4912,11 @@ func (apiCtx *APIContext) getNode(body []byte, expr string, dataFormat df.DataFo
 		}
 
 		if !(dataType.IsValidGoDataType()) {
-			return nil, fmt.Errorf("%s is not any of Go Data types", dataType)
+			return nil, fmt.Errorf("%w: %s is not any of Go Data types", ErrUnsupportedDataType, dataType)
 		}
 	default:
-		return nil, fmt.Errorf("provided unknown format: %s, format should be one of : %s, %s, %s",
-			dataFormat, df.JSON, df.YAML, df.XML)
+		return nil, fmt.Errorf("%w: %s, format should be one of : %s, %s, %s, %s",
+			ErrUnsupportedDataFormat, dataFormat, df.JSON, df.YAML, df.XML, df.HTML)
 	}
 
 	return iValue, nil
@@ -1880,6 +5034,30 @@ func checkFloat(nodeValue any, expectedValue, expr string) error {
 	return nil
 }
 
+// nodeToFloat64 converts node value into float64. Strings are accepted only for data formats without number type: XML and HTML.
+func nodeToFloat64(nodeValue any, dataFormat df.DataFormat, expr string) (float64, error) {
+	if str, ok := nodeValue.(string); ok && (dataFormat == df.XML || dataFormat == df.HTML) {
+		number, err := strconv.ParseFloat(strings.TrimSpace(str), 64)
+		if err != nil {
+			return 0, fmt.Errorf("node '%s' value '%s' could not be converted to float64", expr, str)
+		}
+
+		return number, nil
+	}
+
+	v := reflect.ValueOf(nodeValue)
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), nil
+	default:
+		return 0, fmt.Errorf("expected node '%s' to be number, got '%v'", expr, nodeValue)
+	}
+}
+
 // assertNodeTypeAndValue checks node value against given dataType and its expectedValue yet represented as string
 func assertNodeTypeAndValue(expr string, dataType types.DataType, nodeValue any, expectedValue string) error {
 	switch dataType {
@@ -2,14 +2,29 @@ package gdutils
 
 import (
 	"bytes"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -18,8 +33,13 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/pawelWritesCode/gdutils/pkg/cache"
+	"github.com/pawelWritesCode/gdutils/pkg/collection"
+	"github.com/pawelWritesCode/gdutils/pkg/comparator"
+	"github.com/pawelWritesCode/gdutils/pkg/debugger"
 	"github.com/pawelWritesCode/gdutils/pkg/httpcache"
+	"github.com/pawelWritesCode/gdutils/pkg/httpctx"
 	"github.com/pawelWritesCode/gdutils/pkg/mathutils"
+	"github.com/pawelWritesCode/gdutils/pkg/schema"
 	"github.com/pawelWritesCode/gdutils/pkg/timeutils"
 	"github.com/pawelWritesCode/gdutils/pkg/types"
 	"github.com/pawelWritesCode/gdutils/pkg/validator"
@@ -487,6 +507,16 @@ func TestState_AssertStatusCodeIs(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("failure message contains response body", func(t *testing.T) {
+		s := NewDefaultAPIContext(false, "")
+		s.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{StatusCode: 500, Body: io.NopCloser(strings.NewReader(`{"error": "database is down"}`))})
+
+		want := `expected status code 200, but got 500, response body: {"error": "database is down"}`
+		if err := s.AssertStatusCodeIs(200); err == nil || err.Error() != want {
+			t.Errorf("AssertStatusCodeIs() error = %v, want %s", err, want)
+		}
+	})
 }
 
 func ExampleAPIContext_AssertStatusCodeIs() {
@@ -494,13 +524,13 @@ func ExampleAPIContext_AssertStatusCodeIs() {
 
 	// instead of sending real HTTP(s) request with apiCtx.RequestSend
 	// we simply mock last HTTP(s) request's response
-	apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{StatusCode: 201})
+	apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{StatusCode: 201, Body: io.NopCloser(strings.NewReader(`{"id": 1}`))})
 
 	err := apiCtx.AssertStatusCodeIs(200)
 	fmt.Println(err)
 
 	// Output:
-	// expected status code 200, but got 201
+	// expected status code 200, but got 201, response body: {"id": 1}
 }
 
 func TestAPIContext_AssertStatusCodeIsNot(t *testing.T) {
@@ -538,6 +568,16 @@ func TestAPIContext_AssertStatusCodeIsNot(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("failure message contains response body", func(t *testing.T) {
+		s := NewDefaultAPIContext(false, "")
+		s.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{StatusCode: 500, Body: io.NopCloser(strings.NewReader(`{"error": "database is down"}`))})
+
+		want := `expected status code different than 500, but got 500, response body: {"error": "database is down"}`
+		if err := s.AssertStatusCodeIsNot(500); err == nil || err.Error() != want {
+			t.Errorf("AssertStatusCodeIsNot() error = %v, want %s", err, want)
+		}
+	})
 }
 
 func ExampleAPIContext_AssertStatusCodeIsNot() {
@@ -551,7 +591,97 @@ func ExampleAPIContext_AssertStatusCodeIsNot() {
 	fmt.Println(err)
 
 	// Output:
-	// expected status code different than 200, but got 200
+	// expected status code different than 200, but got 200, response body is empty
+}
+
+func TestAPIContext_AssertStatusCodeIsOfClass(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		class      string
+		wantErr    bool
+	}{
+		{name: "success class", statusCode: 204, class: "2xx", wantErr: false},
+		{name: "client error class", statusCode: 404, class: "4XX", wantErr: false},
+		{name: "different class", statusCode: 500, class: "2xx", wantErr: true},
+		{name: "invalid class", statusCode: 200, class: "200", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{StatusCode: tt.statusCode})
+
+			if err := apiCtx.AssertStatusCodeIsOfClass(tt.class); (err != nil) != tt.wantErr {
+				t.Errorf("AssertStatusCodeIsOfClass() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("missing last response", func(t *testing.T) {
+		if err := NewDefaultAPIContext(false, "").AssertStatusCodeIsOfClass("2xx"); err == nil {
+			t.Errorf("AssertStatusCodeIsOfClass() expected error without last response")
+		}
+	})
+}
+
+func ExampleAPIContext_AssertStatusCodeIsOfClass() {
+	apiCtx := NewDefaultAPIContext(false, "")
+
+	// instead of sending real HTTP(s) request with apiCtx.RequestSend
+	// we simply mock last HTTP(s) request's response
+	apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{StatusCode: 500, Body: io.NopCloser(strings.NewReader(`{"error": "database is down"}`))})
+
+	err := apiCtx.AssertStatusCodeIsOfClass("2xx")
+	fmt.Println(err)
+
+	// Output:
+	// expected status code of class 2xx, but got 500, response body: {"error": "database is down"}
+}
+
+func TestAPIContext_AssertStatusCodeIsOneOf(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		codes      string
+		wantErr    bool
+		wantInErr  string
+	}{
+		{name: "one of codes", statusCode: 201, codes: "200, 201, 204", wantErr: false},
+		{name: "single code", statusCode: 200, codes: "200", wantErr: false},
+		{name: "none of codes", statusCode: 500, body: "internal error", codes: "200,201", wantErr: true, wantInErr: "response body: internal error"},
+		{name: "none of codes - empty body", statusCode: 500, codes: "200", wantErr: true, wantInErr: "response body is empty"},
+		{name: "body longer than debugger limit", statusCode: 500, body: strings.Repeat("a", 4000), codes: "200", wantErr: true,
+			wantInErr: "response body (first 3072 of 4000 bytes): " + strings.Repeat("a", 3072)},
+		{name: "invalid code", statusCode: 200, codes: "200, abc", wantErr: true},
+		{name: "no codes", statusCode: 200, codes: " , ", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{StatusCode: tt.statusCode, ContentLength: int64(len(tt.body)), Body: io.NopCloser(strings.NewReader(tt.body))})
+
+			err := apiCtx.AssertStatusCodeIsOneOf(tt.codes)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AssertStatusCodeIsOneOf() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err != nil && !strings.HasSuffix(err.Error(), tt.wantInErr) {
+				t.Errorf("AssertStatusCodeIsOneOf() error = %v, should end with %s", err, tt.wantInErr)
+			}
+		})
+	}
+
+	t.Run("body is limited to limit of custom debugger", func(t *testing.T) {
+		apiCtx := NewDefaultAPIContext(false, "")
+		apiCtx.SetDebugger(debugger.New(false, false, 5, io.Discard))
+		apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{StatusCode: 500, ContentLength: 8, Body: io.NopCloser(strings.NewReader("abcdefgh"))})
+
+		err := apiCtx.AssertStatusCodeIsOneOf("200")
+		if err == nil || !strings.HasSuffix(err.Error(), "response body (first 5 of 8 bytes): abcde") {
+			t.Errorf("AssertStatusCodeIsOneOf() error = %v, body should be limited to 5 bytes", err)
+		}
+	})
 }
 
 func TestState_AssertResponseFormatIs(t *testing.T) {
@@ -1036,6 +1166,369 @@ func TestState_GetTimeAndTravel(t *testing.T) {
 	}
 }
 
+func TestAPIContext_GenerateFromSchemaByString(t *testing.T) {
+	userSchema := `{
+	"type": "object",
+	"required": ["id", "email", "roles"],
+	"properties": {
+		"id": {"type": "integer", "minimum": 1},
+		"email": {"type": "string", "format": "email"},
+		"roles": {"type": "array", "minItems": 1, "items": {"enum": ["admin", "user"]}},
+		"nick": {"type": "string", "pattern": "^[a-z]{3,6}$"}
+	}
+}`
+
+	type fields struct {
+		cache map[string]any
+	}
+	type args struct {
+		schemaTemplate string
+		mode           schema.GenerationMode
+		cacheKey       string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{name: "empty cache key", args: args{schemaTemplate: userSchema, mode: schema.GenerationModeRandom, cacheKey: ""}, wantErr: true},
+		{name: "invalid schema", args: args{schemaTemplate: `{"type": `, mode: schema.GenerationModeRandom, cacheKey: "USER"}, wantErr: true},
+		{name: "unknown mode", args: args{schemaTemplate: userSchema, mode: "abc", cacheKey: "USER"}, wantErr: true},
+		{name: "missing template value", args: args{schemaTemplate: "{{.SCHEMA}}", mode: schema.GenerationModeRandom, cacheKey: "USER"}, wantErr: true},
+		{name: "random data", args: args{schemaTemplate: userSchema, mode: schema.GenerationModeRandom, cacheKey: "USER"}, wantErr: false},
+		{name: "minimal data", args: args{schemaTemplate: userSchema, mode: schema.GenerationModeMinimal, cacheKey: "USER"}, wantErr: false},
+		{name: "maximal data", args: args{schemaTemplate: userSchema, mode: schema.GenerationModeMaximal, cacheKey: "USER"}, wantErr: false},
+		{name: "schema from template value", fields: fields{cache: map[string]any{"SCHEMA": userSchema}},
+			args: args{schemaTemplate: "{{.SCHEMA}}", mode: schema.GenerationModeRandom, cacheKey: "USER"}, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			for key, val := range tt.fields.cache {
+				apiCtx.Cache.Save(key, val)
+			}
+
+			if err := apiCtx.GenerateFromSchemaByString(tt.args.schemaTemplate, tt.args.mode, tt.args.cacheKey); (err != nil) != tt.wantErr {
+				t.Errorf("GenerateFromSchemaByString() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr {
+				generated, err := apiCtx.Cache.GetSaved(tt.args.cacheKey)
+				if err != nil {
+					t.Errorf("%v", err)
+				}
+
+				if err = apiCtx.SchemaValidators.StringValidator.Validate(generated.(string), userSchema); err != nil {
+					t.Errorf("generated data %s is not valid against schema, err: %v", generated, err)
+				}
+			}
+		})
+	}
+}
+
+func ExampleAPIContext_GenerateFromSchemaByString() {
+	apiCtx := NewDefaultAPIContext(false, "")
+
+	userSchema := `{
+	"type": "object",
+	"required": ["id", "active"],
+	"properties": {
+		"id": {"type": "integer", "minimum": 1},
+		"active": {"type": "boolean"},
+		"name": {"type": "string"}
+	}
+}`
+
+	if err := apiCtx.GenerateFromSchemaByString(userSchema, schema.GenerationModeMinimal, "USER"); err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	user, err := apiCtx.Cache.GetSaved("USER")
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	fmt.Println(user)
+
+	// Output:
+	// {"active":false,"id":1}
+}
+
+func TestAPIContext_GenerateFromSchemaByReference(t *testing.T) {
+	dir := t.TempDir()
+	userSchema := `{"type": "object", "required": ["id"], "properties": {"id": {"type": "string", "format": "uuid"}}}`
+	if err := os.WriteFile(filepath.Join(dir, "user.json"), []byte(userSchema), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	type args struct {
+		referenceTemplate string
+		mode              schema.GenerationMode
+		cacheKey          string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{name: "missing schema", args: args{referenceTemplate: "missing.json", mode: schema.GenerationModeRandom, cacheKey: "USER"}, wantErr: true},
+		{name: "relative path", args: args{referenceTemplate: "user.json", mode: schema.GenerationModeRandom, cacheKey: "USER"}, wantErr: false},
+		{name: "absolute path", args: args{referenceTemplate: filepath.Join(dir, "user.json"), mode: schema.GenerationModeMaximal, cacheKey: "USER"}, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, dir)
+
+			if err := apiCtx.GenerateFromSchemaByReference(tt.args.referenceTemplate, tt.args.mode, tt.args.cacheKey); (err != nil) != tt.wantErr {
+				t.Errorf("GenerateFromSchemaByReference() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr {
+				generated, err := apiCtx.Cache.GetSaved(tt.args.cacheKey)
+				if err != nil {
+					t.Errorf("%v", err)
+				}
+
+				if err = apiCtx.SchemaValidators.StringValidator.Validate(generated.(string), userSchema); err != nil {
+					t.Errorf("generated data %s is not valid against schema, err: %v", generated, err)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIContext_AssertRequestRejectsSchemaViolationsByString(t *testing.T) {
+	userSchema := `{"type": "object", "required": ["name"], "additionalProperties": false, "properties": {"name": {"type": "string", "minLength": 2}, "age": {"type": "integer", "minimum": 18}}}`
+
+	// lenientServer rejects only bodies without name, strictServer validates whole body against schema.
+	lenientServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if _, ok := body["name"].(string); !ok {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer lenientServer.Close()
+
+	strictServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if schema.NewJSONSchemaRawXGValidator().Validate(string(body), userSchema) != nil {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer strictServer.Close()
+
+	type args struct {
+		url          string
+		bodyTemplate string
+		statusCode   int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{name: "base body invalid against schema", args: args{url: strictServer.URL, bodyTemplate: `{"name": "a"}`, statusCode: 422}, wantErr: true},
+		{name: "base body not in JSON format", args: args{url: strictServer.URL, bodyTemplate: `name: abc`, statusCode: 422}, wantErr: true},
+		{name: "server accepts some invalid variants", args: args{url: lenientServer.URL, bodyTemplate: `{"name": "abc", "age": 20}`, statusCode: 422}, wantErr: true},
+		{name: "server rejects all invalid variants", args: args{url: strictServer.URL, bodyTemplate: `{"name": "abc", "age": 20}`, statusCode: 422}, wantErr: false},
+		{name: "server rejects all invalid variants with different status code", args: args{url: strictServer.URL, bodyTemplate: `{"name": "abc", "age": 20}`, statusCode: 400}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			if err := apiCtx.RequestPrepare(http.MethodPost, tt.args.url, "REQ"); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := apiCtx.AssertRequestRejectsSchemaViolationsByString("REQ", tt.args.bodyTemplate, userSchema, tt.args.statusCode); (err != nil) != tt.wantErr {
+				t.Errorf("AssertRequestRejectsSchemaViolationsByString() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAPIContext_AssertResponseBodyEquals(t *testing.T) {
+	type fields struct {
+		body    string
+		options comparator.Options
+	}
+	type args struct {
+		dataFormat  df.DataFormat
+		expected    string
+		ignorePaths string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{name: "unsupported data format", fields: fields{body: "abc"}, args: args{dataFormat: df.PlainText, expected: "abc"}, wantErr: true},
+		{name: "invalid expected document", fields: fields{body: `{"a": 1}`}, args: args{dataFormat: df.JSON, expected: `{"a": `}, wantErr: true},
+		{name: "invalid response body", fields: fields{body: `{"a": `}, args: args{dataFormat: df.JSON, expected: `{"a": 1}`}, wantErr: true},
+		{name: "invalid ignore path", fields: fields{body: `{"a": 1}`}, args: args{dataFormat: df.JSON, expected: `{"a": 1}`, ignorePaths: "a"}, wantErr: true},
+		{name: "equal JSON documents", fields: fields{body: `{"a": 1, "b": [true, null]}`}, args: args{dataFormat: df.JSON, expected: `{"b": [true, null], "a": 1}`}, wantErr: false},
+		{name: "different JSON documents", fields: fields{body: `{"a": 1, "b": [true, null]}`}, args: args{dataFormat: df.JSON, expected: `{"a": 2, "b": [true, null]}`}, wantErr: true},
+		{name: "different JSON documents with ignored paths", fields: fields{body: `{"id": 1, "items": [{"id": 5, "name": "x"}]}`}, args: args{dataFormat: df.JSON, expected: `{"id": 2, "items": [{"id": 6, "name": "x"}]}`, ignorePaths: "$.id, $.items[*].id"}, wantErr: false},
+		{name: "equal YAML documents", fields: fields{body: "a: 1\nb:\n- x\n"}, args: args{dataFormat: df.YAML, expected: "b:\n- x\na: 1\n"}, wantErr: false},
+		{name: "equal XML documents", fields: fields{body: `<user id="1"><name>abc</name></user>`}, args: args{dataFormat: df.XML, expected: `<user id="1">
+	<name>abc</name>
+</user>`}, wantErr: false},
+		{name: "different XML documents", fields: fields{body: `<user id="1"><name>abc</name></user>`}, args: args{dataFormat: df.XML, expected: `<user id="2"><name>abc</name></user>`}, wantErr: true},
+		{name: "array order ignored", fields: fields{body: `[1, 2, 3]`, options: comparator.Options{IgnoreArrayOrder: true}}, args: args{dataFormat: df.JSON, expected: `[3, 1, 2]`}, wantErr: false},
+		{name: "numeric tolerance", fields: fields{body: `{"price": 10.001}`, options: comparator.Options{NumericTolerance: 0.01}}, args: args{dataFormat: df.JSON, expected: `{"price": 10}`}, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.SetComparisonOptions(tt.fields.options)
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(tt.fields.body))})
+
+			if err := apiCtx.AssertResponseBodyEquals(tt.args.dataFormat, tt.args.expected, tt.args.ignorePaths); (err != nil) != tt.wantErr {
+				t.Errorf("AssertResponseBodyEquals() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func ExampleAPIContext_AssertResponseBodyEquals() {
+	apiCtx := NewDefaultAPIContext(false, "")
+
+	// instead of sending real HTTP(s) request with apiCtx.RequestSend
+	// we simply mock last HTTP(s) request's response
+	apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(`{"id": 7, "name": "abc", "tags": ["a", "b"]}`))})
+
+	err := apiCtx.AssertResponseBodyEquals(df.JSON, `{"id": 1, "name": "abd", "tags": ["a"]}`, "$.id")
+	fmt.Println(err)
+
+	// Output:
+	// last HTTP(s) response body is not equal to expected document, found 2 differences:
+	// $.name: expected string "abd", got string "abc"
+	// $.tags[1]: unexpected array element string "b"
+}
+
+func TestAPIContext_AssertResponseBodyContains(t *testing.T) {
+	json := `{"id": "7b1d5d57-8d1d-4c35-9d2c-6c8a3c5e1f10", "name": "abc", "price": 10.5, "tags": ["a", "b"], "createdAt": "2022-01-01T10:00:00Z"}`
+	yaml := "id: 7b1d5d57-8d1d-4c35-9d2c-6c8a3c5e1f10\nname: abc\nprice: 10.5\n"
+	xml := `<product id="7"><name>abc</name><price>10.5</price><tag>a</tag><tag>b</tag></product>`
+
+	type args struct {
+		dataFormat df.DataFormat
+		expected   string
+	}
+	tests := []struct {
+		name    string
+		body    string
+		args    args
+		wantErr bool
+	}{
+		{name: "unsupported data format", body: "abc", args: args{dataFormat: df.HTML, expected: "abc"}, wantErr: true},
+		{name: "JSON subset", body: json, args: args{dataFormat: df.JSON, expected: `{"name": "abc", "tags": ["b"]}`}, wantErr: false},
+		{name: "JSON subset with placeholders", body: json, args: args{dataFormat: df.JSON, expected: `{"id": "<uuid>", "price": "<number>", "createdAt": "<regexp:^\\d{4}-\\d{2}-\\d{2}T>"}`}, wantErr: false},
+		{name: "JSON subset with not matching placeholder", body: json, args: args{dataFormat: df.JSON, expected: `{"name": "<number>"}`}, wantErr: true},
+		{name: "JSON not a subset", body: json, args: args{dataFormat: df.JSON, expected: `{"name": "abc", "tags": ["c"]}`}, wantErr: true},
+		{name: "YAML subset with placeholders", body: yaml, args: args{dataFormat: df.YAML, expected: "name: <any string>\nprice: <number>\n"}, wantErr: false},
+		{name: "XML subset with placeholders", body: xml, args: args{dataFormat: df.XML, expected: `<product id="7"><price>&lt;number&gt;</price><tag>b</tag></product>`}, wantErr: false},
+		{name: "XML not a subset", body: xml, args: args{dataFormat: df.XML, expected: `<product id="8"></product>`}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(tt.body))})
+
+			if err := apiCtx.AssertResponseBodyContains(tt.args.dataFormat, tt.args.expected); (err != nil) != tt.wantErr {
+				t.Errorf("AssertResponseBodyContains() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func ExampleAPIContext_AssertResponseBodyContains() {
+	apiCtx := NewDefaultAPIContext(false, "")
+
+	// instead of sending real HTTP(s) request with apiCtx.RequestSend
+	// we simply mock last HTTP(s) request's response
+	apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(`{"id": 7, "name": "abc", "tags": ["a", "b"]}`))})
+
+	err := apiCtx.AssertResponseBodyContains(df.JSON, `{"id": "<uuid>", "tags": ["b"]}`)
+	fmt.Println(err)
+
+	// Output:
+	// last HTTP(s) response body does not contain expected document, found 1 differences:
+	// $.id: expected value matching placeholder <uuid>, got number 7
+}
+
+func TestAPIContext_AssertResponseBodyMatchesSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	snapshot := filepath.Join(dir, "snapshots", "user.json")
+
+	assertSnapshot := func(body string) error {
+		apiCtx := NewDefaultAPIContext(false, "")
+		apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(body))})
+
+		return apiCtx.AssertResponseBodyMatchesSnapshot(df.JSON, snapshot, "$.id, $.items[*].createdAt")
+	}
+
+	if err := assertSnapshot(`{"id": 1, "name": "abc", "items": [{"createdAt": "2022-01-01"}]}`); err == nil {
+		t.Errorf("missing snapshot should fail assertion, unless snapshots are updated")
+	}
+
+	if _, err := os.Stat(snapshot); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("snapshot should not be written, unless snapshots are updated, err: %v", err)
+	}
+
+	t.Setenv(comparator.UpdateSnapshotsEnv, "true")
+	if err := assertSnapshot(`{"id": 1, "name": "abc", "items": [{"createdAt": "2022-01-01"}]}`); err != nil {
+		t.Fatalf("run with snapshots update should write snapshot, err: %v", err)
+	}
+
+	t.Setenv(comparator.UpdateSnapshotsEnv, "")
+
+	written, err := os.ReadFile(snapshot)
+	if err != nil {
+		t.Fatalf("snapshot was not written, err: %v", err)
+	}
+
+	want := "{\n  \"id\": \"<masked>\",\n  \"items\": [\n    {\n      \"createdAt\": \"<masked>\"\n    }\n  ],\n  \"name\": \"abc\"\n}\n"
+	if string(written) != want {
+		t.Errorf("written snapshot = %q, want %q", written, want)
+	}
+
+	if err = assertSnapshot(`{"name": "abc", "id": 2, "items": [{"createdAt": "2022-02-02"}]}`); err != nil {
+		t.Errorf("body differing only in masked nodes should match snapshot, err: %v", err)
+	}
+
+	if err = assertSnapshot(`{"id": 2, "name": "abd", "items": [{"createdAt": "2022-02-02"}]}`); err == nil {
+		t.Errorf("body differing from snapshot should not match it")
+	}
+
+	if err = assertSnapshot(`{"id": 2, "name": `); err == nil {
+		t.Errorf("invalid body should not match snapshot")
+	}
+
+	t.Setenv(comparator.UpdateSnapshotsEnv, "true")
+	if err = assertSnapshot(`{"id": 2, "name": "abd", "items": []}`); err != nil {
+		t.Errorf("snapshot should be updated, err: %v", err)
+	}
+
+	t.Setenv(comparator.UpdateSnapshotsEnv, "")
+	if err = assertSnapshot(`{"id": 3, "name": "abd", "items": []}`); err != nil {
+		t.Errorf("body should match updated snapshot, err: %v", err)
+	}
+}
+
 func TestState_AssertNodeExists(t *testing.T) {
 	json := `{
 	"users": [
@@ -3333,19 +3826,322 @@ func ExampleAPIContext_AssertNodeIsTypeAndHasOneOfValues() {
 	// node 'user' doesn't contain any of: []string{"def", "ghi"}
 }
 
-func TestAPIContext_AsserNodeContainsSubString(t *testing.T) {
-	type fields struct {
-		lastResponse *http.Response
-		cacheData    map[string]any
-	}
-	type args struct {
-		dataFormat   df.DataFormat
-		exprTemplate string
-		sub          string
-	}
+func TestAPIContext_AssertNodeEqualsCached(t *testing.T) {
+	json := `{"user": {"id": 10, "name": "abc", "tags": ["a", "b"], "score": 1.5}, "id": 10, "code": "10"}`
+	yaml := "user:\n  id: 10\n  name: abc\n"
+	xml := `<user><id>10</id></user>`
+
 	tests := []struct {
-		name    string
-		fields  fields
+		name       string
+		body       string
+		dataFormat df.DataFormat
+		expr       string
+		cached     any
+		wantErr    bool
+	}{
+		{name: "JSON object", body: json, dataFormat: df.JSON, expr: "user",
+			cached: map[string]any{"id": 10, "name": "abc", "tags": []string{"a", "b"}, "score": float32(1.5)}, wantErr: false},
+		{name: "JSON object with different value", body: json, dataFormat: df.JSON, expr: "user",
+			cached: map[string]any{"id": 10, "name": "abd", "tags": []string{"a", "b"}, "score": 1.5}, wantErr: true},
+		{name: "JSON object with different array order", body: json, dataFormat: df.JSON, expr: "user",
+			cached: map[string]any{"id": 10, "name": "abc", "tags": []string{"b", "a"}, "score": 1.5}, wantErr: true},
+		{name: "JSON number saved as int", body: json, dataFormat: df.JSON, expr: "id", cached: 10, wantErr: false},
+		{name: "JSON number saved as string", body: json, dataFormat: df.JSON, expr: "id", cached: "10", wantErr: true},
+		{name: "JSON string saved as number", body: json, dataFormat: df.JSON, expr: "code", cached: 10.0, wantErr: true},
+		{name: "JSON missing node", body: json, dataFormat: df.JSON, expr: "missing", cached: 10, wantErr: true},
+		{name: "YAML mapping", body: yaml, dataFormat: df.YAML, expr: "$.user", cached: map[any]any{"id": uint64(10), "name": "abc"}, wantErr: false},
+		{name: "XML node", body: xml, dataFormat: df.XML, expr: "//id", cached: "10", wantErr: false},
+		{name: "cached value which can not be compared", body: json, dataFormat: df.JSON, expr: "id", cached: func() {}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(tt.body))})
+			apiCtx.Cache.Save("CACHED", tt.cached)
+
+			if err := apiCtx.AssertNodeEqualsCached(tt.dataFormat, tt.expr, "CACHED"); (err != nil) != tt.wantErr {
+				t.Errorf("AssertNodeEqualsCached() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("missing cache key", func(t *testing.T) {
+		apiCtx := NewDefaultAPIContext(false, "")
+		apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(json))})
+
+		if err := apiCtx.AssertNodeEqualsCached(df.JSON, "id", "MISSING"); err == nil {
+			t.Errorf("AssertNodeEqualsCached() expected error for missing cache key")
+		}
+	})
+
+	t.Run("node saved with SaveNode", func(t *testing.T) {
+		apiCtx := NewDefaultAPIContext(false, "")
+		apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(json))})
+		if err := apiCtx.SaveNode(df.JSON, "user", "USER"); err != nil {
+			t.Fatal(err)
+		}
+
+		apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(
+			`{"data": {"score": 1.50, "tags": ["a", "b"], "name": "abc", "id": 10.0}}`))})
+		if err := apiCtx.AssertNodeEqualsCached(df.JSON, "data", "USER"); err != nil {
+			t.Errorf("AssertNodeEqualsCached() error = %v", err)
+		}
+	})
+}
+
+func ExampleAPIContext_AssertNodeEqualsCached() {
+	apiCtx := NewDefaultAPIContext(false, "")
+	apiCtx.Cache.Save("USER", map[string]any{"id": 1, "name": "abc"})
+
+	// instead of sending real HTTP(s) request with apiCtx.RequestSend
+	// we simply mock last HTTP(s) request's response
+	apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(
+		`{"user": {"id": "1", "name": "abc"}}`))})
+
+	err := apiCtx.AssertNodeEqualsCached(df.JSON, "user", "USER")
+	fmt.Println(err)
+
+	// Output:
+	// node 'user' is not equal to value saved in cache under key 'USER', found 1 differences:
+	// $.id: expected number 1, got string "1"
+}
+
+func TestAPIContext_AssertNodeNotEqualsCached(t *testing.T) {
+	json := `{"user": {"id": 10, "name": "abc"}, "id": 10}`
+
+	tests := []struct {
+		name    string
+		expr    string
+		cached  any
+		wantErr bool
+	}{
+		{name: "equal object", expr: "user", cached: map[string]any{"name": "abc", "id": 10}, wantErr: true},
+		{name: "different object", expr: "user", cached: map[string]any{"name": "abc", "id": 11}, wantErr: false},
+		{name: "equal number", expr: "id", cached: 10.0, wantErr: true},
+		{name: "number saved as string", expr: "id", cached: "10", wantErr: false},
+		{name: "missing node", expr: "missing", cached: 10, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(json))})
+			apiCtx.Cache.Save("CACHED", tt.cached)
+
+			if err := apiCtx.AssertNodeNotEqualsCached(df.JSON, tt.expr, "CACHED"); (err != nil) != tt.wantErr {
+				t.Errorf("AssertNodeNotEqualsCached() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAPIContext_AssertNodeNumberCompare(t *testing.T) {
+	json := `{"price": 10.5, "count": 3, "name": "abc", "code": "12"}`
+	yaml := "price: 10.5\ncount: 3\n"
+	xml := `<product><price>10.5</price><name>abc</name></product>`
+	html := `<html><body><span id="price"> 10.5 </span></body></html>`
+
+	type args struct {
+		dataFormat df.DataFormat
+		expr       string
+		operator   mathutils.Operator
+		value      string
+	}
+	tests := []struct {
+		name    string
+		body    string
+		args    args
+		wantErr bool
+	}{
+		{name: "JSON greater", body: json, args: args{dataFormat: df.JSON, expr: "price", operator: mathutils.OperatorGreater, value: "10"}, wantErr: false},
+		{name: "JSON not greater", body: json, args: args{dataFormat: df.JSON, expr: "price", operator: mathutils.OperatorGreater, value: "10.5"}, wantErr: true},
+		{name: "JSON greater or equal", body: json, args: args{dataFormat: df.JSON, expr: "price", operator: mathutils.OperatorGreaterOrEqual, value: "10.5"}, wantErr: false},
+		{name: "JSON less", body: json, args: args{dataFormat: df.JSON, expr: "count", operator: mathutils.OperatorLess, value: "4"}, wantErr: false},
+		{name: "JSON less or equal", body: json, args: args{dataFormat: df.JSON, expr: "count", operator: mathutils.OperatorLessOrEqual, value: "2"}, wantErr: true},
+		{name: "JSON between", body: json, args: args{dataFormat: df.JSON, expr: "price", operator: mathutils.OperatorBetween, value: "10, 11"}, wantErr: false},
+		{name: "JSON approx", body: json, args: args{dataFormat: df.JSON, expr: "price", operator: mathutils.OperatorApprox, value: "10.49, 0.02"}, wantErr: false},
+		{name: "JSON not approx", body: json, args: args{dataFormat: df.JSON, expr: "price", operator: mathutils.OperatorApprox, value: "10.4, 0.02"}, wantErr: true},
+		{name: "JSON string node", body: json, args: args{dataFormat: df.JSON, expr: "code", operator: mathutils.OperatorGreater, value: "1"}, wantErr: true},
+		{name: "JSON missing node", body: json, args: args{dataFormat: df.JSON, expr: "missing", operator: mathutils.OperatorGreater, value: "1"}, wantErr: true},
+		{name: "invalid value", body: json, args: args{dataFormat: df.JSON, expr: "price", operator: mathutils.OperatorGreater, value: "abc"}, wantErr: true},
+		{name: "invalid number of values", body: json, args: args{dataFormat: df.JSON, expr: "price", operator: mathutils.OperatorBetween, value: "1"}, wantErr: true},
+		{name: "unknown operator", body: json, args: args{dataFormat: df.JSON, expr: "price", operator: "==", value: "1"}, wantErr: true},
+		{name: "YAML between", body: yaml, args: args{dataFormat: df.YAML, expr: "$.count", operator: mathutils.OperatorBetween, value: "3, 3"}, wantErr: false},
+		{name: "YAML greater", body: yaml, args: args{dataFormat: df.YAML, expr: "$.price", operator: mathutils.OperatorGreater, value: "11"}, wantErr: true},
+		{name: "XML greater", body: xml, args: args{dataFormat: df.XML, expr: "//price", operator: mathutils.OperatorGreater, value: "10"}, wantErr: false},
+		{name: "XML not a number", body: xml, args: args{dataFormat: df.XML, expr: "//name", operator: mathutils.OperatorGreater, value: "10"}, wantErr: true},
+		{name: "HTML less", body: html, args: args{dataFormat: df.HTML, expr: "//span[@id='price']", operator: mathutils.OperatorLess, value: "11"}, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(tt.body))})
+
+			if err := apiCtx.AssertNodeNumberCompare(tt.args.dataFormat, tt.args.expr, tt.args.operator, tt.args.value); (err != nil) != tt.wantErr {
+				t.Errorf("AssertNodeNumberCompare() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func ExampleAPIContext_AssertNodeNumberCompare() {
+	apiCtx := NewDefaultAPIContext(false, "")
+
+	// instead of sending real HTTP(s) request with apiCtx.RequestSend
+	// we simply mock last HTTP(s) request's response
+	apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(`{"price": 10.5}`))})
+
+	err := apiCtx.AssertNodeNumberCompare(df.JSON, "price", mathutils.OperatorBetween, "1, 10")
+	fmt.Println(err)
+
+	// Output:
+	// node 'price' has value 10.5, which does not satisfy condition: between 1, 10
+}
+
+func TestAPIContext_AssertNodeTimeIsBefore(t *testing.T) {
+	json := `{"createdAt": "2022-03-04T10:20:30Z", "updatedAt": 1646389230, "deletedAt": "04.03.2022", "name": "abc"}`
+	xml := `<user><createdAt>1646389230000</createdAt></user>`
+
+	type args struct {
+		body       string
+		dataFormat df.DataFormat
+		expr       string
+		layout     string
+		cacheKey   string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{name: "RFC3339 before", args: args{body: json, dataFormat: df.JSON, expr: "createdAt", cacheKey: "LATER"}, wantErr: false},
+		{name: "RFC3339 not before", args: args{body: json, dataFormat: df.JSON, expr: "createdAt", cacheKey: "EARLIER"}, wantErr: true},
+		{name: "unix seconds before", args: args{body: json, dataFormat: df.JSON, expr: "updatedAt", layout: timeutils.LayoutUnix, cacheKey: "LATER"}, wantErr: false},
+		{name: "custom layout before", args: args{body: json, dataFormat: df.JSON, expr: "deletedAt", layout: "02.01.2006", cacheKey: "LATER"}, wantErr: false},
+		{name: "XML unix milliseconds not before", args: args{body: xml, dataFormat: df.XML, expr: "//createdAt", layout: timeutils.LayoutUnixMilli, cacheKey: "EARLIER"}, wantErr: true},
+		{name: "node is not time", args: args{body: json, dataFormat: df.JSON, expr: "name", cacheKey: "LATER"}, wantErr: true},
+		{name: "invalid layout", args: args{body: json, dataFormat: df.JSON, expr: "createdAt", layout: timeutils.LayoutUnix, cacheKey: "LATER"}, wantErr: true},
+		{name: "missing cached time", args: args{body: json, dataFormat: df.JSON, expr: "createdAt", cacheKey: "MISSING"}, wantErr: true},
+		{name: "cached value is not time", args: args{body: json, dataFormat: df.JSON, expr: "createdAt", cacheKey: "NOT_TIME"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(tt.args.body))})
+			apiCtx.Cache.Save("EARLIER", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+			apiCtx.Cache.Save("LATER", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+			apiCtx.Cache.Save("NOT_TIME", "2023-01-01T00:00:00Z")
+
+			if err := apiCtx.AssertNodeTimeIsBefore(tt.args.dataFormat, tt.args.expr, tt.args.layout, tt.args.cacheKey); (err != nil) != tt.wantErr {
+				t.Errorf("AssertNodeTimeIsBefore() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAPIContext_AssertNodeTimeIsAfter(t *testing.T) {
+	apiCtx := NewDefaultAPIContext(false, "")
+	apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(`{"createdAt": "2022-03-04T10:20:30+02:00"}`))})
+
+	if err := apiCtx.GetTimeAndTravel(time.Date(2022, 3, 4, 8, 20, 30, 0, time.UTC), timeutils.TimeDirectionBackward, time.Second, "EARLIER"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := apiCtx.AssertNodeTimeIsAfter(df.JSON, "createdAt", "", "EARLIER"); err != nil {
+		t.Errorf("AssertNodeTimeIsAfter() error = %v", err)
+	}
+
+	if err := apiCtx.GetTimeAndTravel(time.Date(2022, 3, 4, 8, 20, 30, 0, time.UTC), timeutils.TimeDirectionForward, 0, "SAME"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := apiCtx.AssertNodeTimeIsAfter(df.JSON, "createdAt", "", "SAME"); err == nil {
+		t.Errorf("AssertNodeTimeIsAfter() expected error for the same time")
+	}
+}
+
+func TestAPIContext_AssertNodeTimeIsWithin(t *testing.T) {
+	now := time.Now()
+	body := fmt.Sprintf(`{"recent": "%s", "old": "%s", "recentUnix": %d}`, now.Add(-2*time.Second).Format(time.RFC3339), now.Add(-time.Hour).Format(time.RFC3339), now.Unix())
+
+	tests := []struct {
+		name     string
+		expr     string
+		layout   string
+		interval time.Duration
+		wantErr  bool
+	}{
+		{name: "recent time", expr: "recent", interval: time.Minute, wantErr: false},
+		{name: "old time", expr: "old", interval: time.Minute, wantErr: true},
+		{name: "recent unix time", expr: "recentUnix", layout: timeutils.LayoutUnix, interval: time.Minute, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(body))})
+
+			if err := apiCtx.AssertNodeTimeIsWithin(df.JSON, tt.expr, tt.layout, tt.interval); (err != nil) != tt.wantErr {
+				t.Errorf("AssertNodeTimeIsWithin() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAPIContext_AssertNodeTimeIsInTimezone(t *testing.T) {
+	body := `{"summer": "2022-07-01T10:00:00+02:00", "utc": "2022-07-01T10:00:00Z", "unix": 1646389230}`
+
+	tests := []struct {
+		name     string
+		expr     string
+		layout   string
+		timezone string
+		wantErr  bool
+	}{
+		{name: "location", expr: "summer", timezone: "Europe/Warsaw", wantErr: false},
+		{name: "offset", expr: "summer", timezone: "+02:00", wantErr: false},
+		{name: "different timezone", expr: "summer", timezone: "UTC", wantErr: true},
+		{name: "UTC", expr: "utc", timezone: "UTC", wantErr: false},
+		{name: "unknown timezone", expr: "utc", timezone: "Mars/Olympus", wantErr: true},
+		{name: "unix time", expr: "unix", layout: timeutils.LayoutUnix, timezone: "UTC", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(body))})
+
+			if err := apiCtx.AssertNodeTimeIsInTimezone(df.JSON, tt.expr, tt.layout, tt.timezone); (err != nil) != tt.wantErr {
+				t.Errorf("AssertNodeTimeIsInTimezone() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func ExampleAPIContext_AssertNodeTimeIsInTimezone() {
+	apiCtx := NewDefaultAPIContext(false, "")
+
+	// instead of sending real HTTP(s) request with apiCtx.RequestSend
+	// we simply mock last HTTP(s) request's response
+	apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(`{"createdAt": "2022-07-01T10:00:00Z"}`))})
+
+	err := apiCtx.AssertNodeTimeIsInTimezone(df.JSON, "createdAt", "", "+02:00")
+	fmt.Println(err)
+
+	// Output:
+	// node 'createdAt' has time 2022-07-01T10:00:00Z, which is not in timezone +02:00
+}
+
+func TestAPIContext_AsserNodeContainsSubString(t *testing.T) {
+	type fields struct {
+		lastResponse *http.Response
+		cacheData    map[string]any
+	}
+	type args struct {
+		dataFormat   df.DataFormat
+		exprTemplate string
+		sub          string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
 		args    args
 		wantErr bool
 	}{
@@ -4010,102 +4806,390 @@ func ExampleAPIContext_AssertNodeNotContainsSubString() {
 	// node 'user' string value contain some 'a', but expected not to
 }
 
-func TestState_AssertNodeMatchesRegExp(t *testing.T) {
-	mTemplateEngine := new(mockedTemplateEngine)
-	mJsonPathResolver := new(mockedJsonPathFinder)
+func TestAPIContext_AssertNodeElementsHaveValue(t *testing.T) {
+	json := `{"users": [{"name": "abc", "age": 30, "active": true}, {"name": "def", "age": 30, "active": false}, {"name": "abc", "age": 20}], "tags": ["a", "a"], "name": "abc"}`
+	yaml := "users:\n  - name: abc\n    age: 30\n  - name: def\n    age: 30\n"
 
-	type fields struct {
-		cacheKeys      map[string]any
-		templateEngine templateEngine
-		pathResolvers  PathFinders
-		respBody       string
-		mockFunc       func()
-	}
 	type args struct {
-		df             df.DataFormat
-		expr           string
-		regExpTemplate string
+		dataFormat df.DataFormat
+		expr       string
+		quantifier collection.Quantifier
+		subExpr    string
+		value      string
 	}
 	tests := []struct {
 		name    string
-		fields  fields
+		body    string
 		args    args
 		wantErr bool
 	}{
-		{name: "template engine error", fields: fields{
-			cacheKeys:      nil,
-			templateEngine: mTemplateEngine,
-			pathResolvers: PathFinders{
-				JSON: nil,
-				YAML: nil,
-			},
-			respBody: "",
-			mockFunc: func() {
-				mTemplateEngine.On("Replace", "abc", mock.Anything).
-					Return("", errors.New("err")).Once()
-			},
-		}, args: args{
-			df:             df.JSON,
-			expr:           "",
-			regExpTemplate: "abc",
-		}, wantErr: true},
-		{name: "json path finder error", fields: fields{
-			cacheKeys:      nil,
-			templateEngine: mTemplateEngine,
-			pathResolvers:  PathFinders{JSON: mJsonPathResolver},
-			respBody:       "",
-			mockFunc: func() {
-				mTemplateEngine.On("Replace", "abc", mock.Anything).
-					Return("abc", nil).Once()
+		{name: "JSON every element has value", body: json, args: args{dataFormat: df.JSON, expr: "tags", quantifier: collection.QuantifierEvery, value: "a"}, wantErr: false},
+		{name: "JSON every element's node has value", body: json, args: args{dataFormat: df.JSON, expr: "users", quantifier: collection.QuantifierEvery, subExpr: "age", value: "30"}, wantErr: true},
+		{name: "JSON any element's node has value", body: json, args: args{dataFormat: df.JSON, expr: "users", quantifier: collection.QuantifierAny, subExpr: "name", value: "def"}, wantErr: false},
+		{name: "JSON any element's node has value - missing", body: json, args: args{dataFormat: df.JSON, expr: "users", quantifier: collection.QuantifierAny, subExpr: "name", value: "ghi"}, wantErr: true},
+		{name: "JSON none element's node has value", body: json, args: args{dataFormat: df.JSON, expr: "users", quantifier: collection.QuantifierNone, subExpr: "age", value: "40"}, wantErr: false},
+		{name: "JSON none element's node has value - found", body: json, args: args{dataFormat: df.JSON, expr: "users", quantifier: collection.QuantifierNone, subExpr: "active", value: "true"}, wantErr: true},
+		{name: "JSON element without node does not match", body: json, args: args{dataFormat: df.JSON, expr: "users", quantifier: collection.QuantifierNone, subExpr: "active", value: "false"}, wantErr: true},
+		{name: "JSON node is not array", body: json, args: args{dataFormat: df.JSON, expr: "name", quantifier: collection.QuantifierEvery, value: "abc"}, wantErr: true},
+		{name: "JSON missing node", body: json, args: args{dataFormat: df.JSON, expr: "missing", quantifier: collection.QuantifierEvery, value: "abc"}, wantErr: true},
+		{name: "unknown quantifier", body: json, args: args{dataFormat: df.JSON, expr: "tags", quantifier: "all", value: "a"}, wantErr: true},
+		{name: "YAML every element's node has value", body: yaml, args: args{dataFormat: df.YAML, expr: "$.users", quantifier: collection.QuantifierEvery, subExpr: "$.age", value: "30"}, wantErr: false},
+		{name: "YAML any element's node has value", body: yaml, args: args{dataFormat: df.YAML, expr: "$.users", quantifier: collection.QuantifierAny, subExpr: "$.name", value: "xyz"}, wantErr: true},
+		{name: "XML is not supported", body: `<users><name>abc</name></users>`, args: args{dataFormat: df.XML, expr: "//name", quantifier: collection.QuantifierEvery, value: "abc"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(tt.body))})
 
-				mTemplateEngine.On("Replace", "xxx", mock.Anything).
-					Return("xxx", nil).Once()
+			if err := apiCtx.AssertNodeElementsHaveValue(tt.args.dataFormat, tt.args.expr, tt.args.quantifier, tt.args.subExpr, tt.args.value); (err != nil) != tt.wantErr {
+				t.Errorf("AssertNodeElementsHaveValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
 
-				mJsonPathResolver.On("Find", "xxx", []byte("")).
-					Return(any(""), errors.New("err")).Once()
-			},
-		}, args: args{
-			df:             df.JSON,
-			expr:           "xxx",
-			regExpTemplate: "abc",
-		}, wantErr: true},
-		{name: "node value does not match provided regExp #1", fields: fields{
-			respBody: `{
-	"name": "abcdef"
-}`,
-			mockFunc: func() {},
-		}, args: args{
-			df:             df.JSON,
-			expr:           "name",
-			regExpTemplate: "dd.*",
-		}, wantErr: true},
+func ExampleAPIContext_AssertNodeElementsHaveValue() {
+	apiCtx := NewDefaultAPIContext(false, "")
 
-		{name: "node value matches provided regExp #1", fields: fields{
-			respBody: `{
-	"name": "abcdef"
-}`,
-			mockFunc: func() {},
-		}, args: args{
-			df:             df.JSON,
-			expr:           "name",
-			regExpTemplate: "abc.*",
-		}, wantErr: false},
+	// instead of sending real HTTP(s) request with apiCtx.RequestSend
+	// we simply mock last HTTP(s) request's response
+	apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(
+		`{"users": [{"status": "active"}, {"status": "banned"}, {"status": "active"}, {"status": "banned"}]}`))})
 
-		{name: "node value does not match provided regExp #2", fields: fields{
-			respBody: `---
-name: abcdef`,
-			mockFunc: func() {},
-		}, args: args{
-			df:             df.YAML,
-			expr:           "$.name",
-			regExpTemplate: "dd.*",
-		}, wantErr: true},
+	err := apiCtx.AssertNodeElementsHaveValue(df.JSON, "users", collection.QuantifierEvery, "status", "active")
+	fmt.Println(err)
 
-		{name: "node value matches provided regExp #2", fields: fields{
-			respBody: `---
-name: abcdef`,
-			mockFunc: func() {},
-		}, args: args{
+	// Output:
+	// elements of node 'users' at indices [1 3] do not satisfy condition: element's node 'status' is equal to 'active'
+}
+
+func TestAPIContext_AssertNodeElementsMatchRegExp(t *testing.T) {
+	json := `{"users": [{"email": "abc@example.com"}, {"email": "def@example.com"}], "ids": [1, 22, 333]}`
+	yaml := "emails:\n  - abc@example.com\n  - invalid\n"
+
+	type args struct {
+		dataFormat df.DataFormat
+		expr       string
+		quantifier collection.Quantifier
+		subExpr    string
+		regExp     string
+	}
+	tests := []struct {
+		name    string
+		body    string
+		args    args
+		wantErr bool
+	}{
+		{name: "JSON every element's node matches", body: json, args: args{dataFormat: df.JSON, expr: "users", quantifier: collection.QuantifierEvery, subExpr: "email", regExp: `@example\.com$`}, wantErr: false},
+		{name: "JSON every element matches - numbers", body: json, args: args{dataFormat: df.JSON, expr: "ids", quantifier: collection.QuantifierEvery, regExp: `^\d{1,2}$`}, wantErr: true},
+		{name: "JSON any element matches", body: json, args: args{dataFormat: df.JSON, expr: "ids", quantifier: collection.QuantifierAny, regExp: `^\d{3}$`}, wantErr: false},
+		{name: "JSON none element matches", body: json, args: args{dataFormat: df.JSON, expr: "users", quantifier: collection.QuantifierNone, subExpr: "email", regExp: `^def`}, wantErr: true},
+		{name: "invalid regExp", body: json, args: args{dataFormat: df.JSON, expr: "ids", quantifier: collection.QuantifierAny, regExp: `(`}, wantErr: true},
+		{name: "YAML every element matches", body: yaml, args: args{dataFormat: df.YAML, expr: "$.emails", quantifier: collection.QuantifierEvery, regExp: `@`}, wantErr: true},
+		{name: "YAML any element matches", body: yaml, args: args{dataFormat: df.YAML, expr: "$.emails", quantifier: collection.QuantifierAny, regExp: `@`}, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(tt.body))})
+
+			if err := apiCtx.AssertNodeElementsMatchRegExp(tt.args.dataFormat, tt.args.expr, tt.args.quantifier, tt.args.subExpr, tt.args.regExp); (err != nil) != tt.wantErr {
+				t.Errorf("AssertNodeElementsMatchRegExp() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAPIContext_AssertNodeElementsAreType(t *testing.T) {
+	json := `{"users": [{"id": 1, "name": "abc"}, {"id": "2", "name": null}], "ids": [1, 2, 3]}`
+	yaml := "ids:\n  - 1\n  - 2\n"
+
+	type args struct {
+		dataFormat df.DataFormat
+		expr       string
+		quantifier collection.Quantifier
+		subExpr    string
+		dataType   types.DataType
+	}
+	tests := []struct {
+		name    string
+		body    string
+		args    args
+		wantErr bool
+	}{
+		{name: "JSON every element is number", body: json, args: args{dataFormat: df.JSON, expr: "ids", quantifier: collection.QuantifierEvery, dataType: types.Number}, wantErr: false},
+		{name: "JSON every element's node is number", body: json, args: args{dataFormat: df.JSON, expr: "users", quantifier: collection.QuantifierEvery, subExpr: "id", dataType: types.Number}, wantErr: true},
+		{name: "JSON any element's node is string", body: json, args: args{dataFormat: df.JSON, expr: "users", quantifier: collection.QuantifierAny, subExpr: "id", dataType: types.String}, wantErr: false},
+		{name: "JSON none element's node is null", body: json, args: args{dataFormat: df.JSON, expr: "users", quantifier: collection.QuantifierNone, subExpr: "name", dataType: types.Null}, wantErr: true},
+		{name: "JSON every element is object", body: json, args: args{dataFormat: df.JSON, expr: "users", quantifier: collection.QuantifierEvery, dataType: types.Object}, wantErr: false},
+		{name: "YAML every element is integer", body: yaml, args: args{dataFormat: df.YAML, expr: "$.ids", quantifier: collection.QuantifierEvery, dataType: types.Int}, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(tt.body))})
+
+			if err := apiCtx.AssertNodeElementsAreType(tt.args.dataFormat, tt.args.expr, tt.args.quantifier, tt.args.subExpr, tt.args.dataType); (err != nil) != tt.wantErr {
+				t.Errorf("AssertNodeElementsAreType() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAPIContext_AssertNodeElementsAreSorted(t *testing.T) {
+	json := `{"users": [{"name": "abc", "age": 20, "createdAt": "2022-03-04T10:00:00Z"}, {"name": "abd", "age": 30, "createdAt": "2022-03-04T09:00:00Z"}, {"name": "b", "age": 30, "createdAt": "2022-03-04T08:00:00Z"}], "ids": [10, 9, 2], "name": "abc"}`
+	yaml := "ids:\n  - 1\n  - 2\n  - 10\n"
+
+	type args struct {
+		dataFormat df.DataFormat
+		expr       string
+		subExpr    string
+		order      collection.Order
+		comparison collection.Comparison
+		layout     string
+	}
+	tests := []struct {
+		name    string
+		body    string
+		args    args
+		wantErr bool
+	}{
+		{name: "JSON ascending by string", body: json, args: args{dataFormat: df.JSON, expr: "users", subExpr: "name", order: collection.OrderAscending, comparison: collection.ComparisonString}, wantErr: false},
+		{name: "JSON descending by string", body: json, args: args{dataFormat: df.JSON, expr: "users", subExpr: "name", order: collection.OrderDescending, comparison: collection.ComparisonString}, wantErr: true},
+		{name: "JSON ascending by number", body: json, args: args{dataFormat: df.JSON, expr: "users", subExpr: "age", order: collection.OrderAscending, comparison: collection.ComparisonNumber}, wantErr: false},
+		{name: "JSON descending numbers", body: json, args: args{dataFormat: df.JSON, expr: "ids", order: collection.OrderDescending, comparison: collection.ComparisonNumber}, wantErr: false},
+		{name: "JSON descending numbers compared as strings", body: json, args: args{dataFormat: df.JSON, expr: "ids", order: collection.OrderDescending, comparison: collection.ComparisonString}, wantErr: true},
+		{name: "JSON descending by time", body: json, args: args{dataFormat: df.JSON, expr: "users", subExpr: "createdAt", order: collection.OrderDescending, comparison: collection.ComparisonTime}, wantErr: false},
+		{name: "JSON ascending by time", body: json, args: args{dataFormat: df.JSON, expr: "users", subExpr: "createdAt", order: collection.OrderAscending, comparison: collection.ComparisonTime}, wantErr: true},
+		{name: "JSON by time with invalid layout", body: json, args: args{dataFormat: df.JSON, expr: "users", subExpr: "createdAt", order: collection.OrderDescending, comparison: collection.ComparisonTime, layout: timeutils.LayoutUnix}, wantErr: true},
+		{name: "JSON strings compared as numbers", body: json, args: args{dataFormat: df.JSON, expr: "users", subExpr: "name", order: collection.OrderAscending, comparison: collection.ComparisonNumber}, wantErr: true},
+		{name: "JSON missing sub node", body: json, args: args{dataFormat: df.JSON, expr: "users", subExpr: "email", order: collection.OrderAscending, comparison: collection.ComparisonString}, wantErr: true},
+		{name: "JSON node is not array", body: json, args: args{dataFormat: df.JSON, expr: "name", order: collection.OrderAscending, comparison: collection.ComparisonString}, wantErr: true},
+		{name: "unknown order", body: json, args: args{dataFormat: df.JSON, expr: "ids", order: "random", comparison: collection.ComparisonNumber}, wantErr: true},
+		{name: "unknown comparison", body: json, args: args{dataFormat: df.JSON, expr: "ids", order: collection.OrderAscending, comparison: "bool"}, wantErr: true},
+		{name: "YAML ascending numbers", body: yaml, args: args{dataFormat: df.YAML, expr: "$.ids", order: collection.OrderAscending, comparison: collection.ComparisonNumber}, wantErr: false},
+		{name: "YAML ascending numbers compared as strings", body: yaml, args: args{dataFormat: df.YAML, expr: "$.ids", order: collection.OrderAscending, comparison: collection.ComparisonString}, wantErr: true},
+		{name: "XML is not supported", body: `<ids><id>1</id></ids>`, args: args{dataFormat: df.XML, expr: "//id", order: collection.OrderAscending, comparison: collection.ComparisonNumber}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(tt.body))})
+
+			if err := apiCtx.AssertNodeElementsAreSorted(tt.args.dataFormat, tt.args.expr, tt.args.subExpr, tt.args.order, tt.args.comparison, tt.args.layout); (err != nil) != tt.wantErr {
+				t.Errorf("AssertNodeElementsAreSorted() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func ExampleAPIContext_AssertNodeElementsAreSorted() {
+	apiCtx := NewDefaultAPIContext(false, "")
+
+	// instead of sending real HTTP(s) request with apiCtx.RequestSend
+	// we simply mock last HTTP(s) request's response
+	apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(
+		`{"users": [{"age": 20}, {"age": 30}, {"age": 25}]}`))})
+
+	err := apiCtx.AssertNodeElementsAreSorted(df.JSON, "users", "age", collection.OrderAscending, collection.ComparisonNumber, "")
+	fmt.Println(err)
+
+	// Output:
+	// elements of node 'users' are not sorted in asc order by element's node 'age', element 2 with value '25' is out of order after element 1 with value '30'
+}
+
+func TestAPIContext_AssertNodeElementsAreUnique(t *testing.T) {
+	json := `{"users": [{"id": 1, "name": "abc"}, {"id": 2, "name": "abc"}, {"id": 3, "name": "def"}], "tags": [{"a": 1}, {"a": 1}]}`
+	yaml := "ids:\n  - 1\n  - 2\n  - 1\n"
+
+	type args struct {
+		dataFormat df.DataFormat
+		expr       string
+		subExpr    string
+	}
+	tests := []struct {
+		name    string
+		body    string
+		args    args
+		wantErr bool
+	}{
+		{name: "JSON unique sub nodes", body: json, args: args{dataFormat: df.JSON, expr: "users", subExpr: "id"}, wantErr: false},
+		{name: "JSON duplicated sub nodes", body: json, args: args{dataFormat: df.JSON, expr: "users", subExpr: "name"}, wantErr: true},
+		{name: "JSON unique elements", body: json, args: args{dataFormat: df.JSON, expr: "users"}, wantErr: false},
+		{name: "JSON duplicated object elements", body: json, args: args{dataFormat: df.JSON, expr: "tags"}, wantErr: true},
+		{name: "JSON missing sub node", body: json, args: args{dataFormat: df.JSON, expr: "users", subExpr: "email"}, wantErr: true},
+		{name: "YAML duplicated elements", body: yaml, args: args{dataFormat: df.YAML, expr: "$.ids"}, wantErr: true},
+		{name: "JSON values of different types", body: `{"values": [1, "1", true, "true", null, "null", {"a": 1}, {"a": "1"}]}`,
+			args: args{dataFormat: df.JSON, expr: "values"}, wantErr: false},
+		{name: "JSON objects with different keys order", body: `{"values": [{"a": 1, "b": 2}, {"b": 2, "a": 1}]}`,
+			args: args{dataFormat: df.JSON, expr: "values"}, wantErr: true},
+		{name: "YAML nested objects", body: "values:\n  - a: {b: 1}\n  - a: {b: \"1\"}\n  - a: {b: 1}\n",
+			args: args{dataFormat: df.YAML, expr: "$.values"}, wantErr: true},
+		{name: "YAML values of different types", body: "values:\n  - 1\n  - \"1\"\n  - true\n  - \"true\"\n",
+			args: args{dataFormat: df.YAML, expr: "$.values"}, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(tt.body))})
+
+			if err := apiCtx.AssertNodeElementsAreUnique(tt.args.dataFormat, tt.args.expr, tt.args.subExpr); (err != nil) != tt.wantErr {
+				t.Errorf("AssertNodeElementsAreUnique() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func ExampleAPIContext_AssertNodeElementsAreUnique() {
+	apiCtx := NewDefaultAPIContext(false, "")
+
+	// instead of sending real HTTP(s) request with apiCtx.RequestSend
+	// we simply mock last HTTP(s) request's response
+	apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(
+		`{"users": [{"id": 1}, {"id": 2}, {"id": 1}, {"id": 3}, {"id": 2}]}`))})
+
+	err := apiCtx.AssertNodeElementsAreUnique(df.JSON, "users", "id")
+	fmt.Println(err)
+
+	// Output:
+	// elements of node 'users' have duplicated values of element's node 'id': '1' at indices [0 2], '2' at indices [1 4]
+}
+
+func TestAPIContext_AssertNodeHasSameElementsAs(t *testing.T) {
+	json := `{"ids": [3, 1, 2, 2], "users": [{"id": 1, "name": "abc"}, {"id": 2, "name": "def"}], "name": "abc"}`
+	yaml := "ids:\n  - 3\n  - 1\n  - 2\n"
+
+	type args struct {
+		dataFormat df.DataFormat
+		expr       string
+		expected   string
+	}
+	tests := []struct {
+		name    string
+		body    string
+		args    args
+		wantErr bool
+	}{
+		{name: "JSON same elements in different order", body: json, args: args{dataFormat: df.JSON, expr: "ids", expected: `[2, 1, 2, 3]`}, wantErr: false},
+		{name: "JSON repeated elements are counted", body: json, args: args{dataFormat: df.JSON, expr: "ids", expected: `[1, 2, 3]`}, wantErr: true},
+		{name: "JSON different elements", body: json, args: args{dataFormat: df.JSON, expr: "ids", expected: `[1, 2, 2, 4]`}, wantErr: true},
+		{name: "JSON same objects in different order", body: json, args: args{dataFormat: df.JSON, expr: "users", expected: `[{"name": "def", "id": 2}, {"id": 1, "name": "abc"}]`}, wantErr: false},
+		{name: "JSON expected value is not array", body: json, args: args{dataFormat: df.JSON, expr: "ids", expected: `{"a": 1}`}, wantErr: true},
+		{name: "JSON invalid expected value", body: json, args: args{dataFormat: df.JSON, expr: "ids", expected: `[1, 2`}, wantErr: true},
+		{name: "JSON node is not array", body: json, args: args{dataFormat: df.JSON, expr: "name", expected: `["abc"]`}, wantErr: true},
+		{name: "YAML same elements in different order", body: yaml, args: args{dataFormat: df.YAML, expr: "$.ids", expected: "- 1\n- 2\n- 3\n"}, wantErr: false},
+		{name: "YAML different elements", body: yaml, args: args{dataFormat: df.YAML, expr: "$.ids", expected: "[1, 2]"}, wantErr: true},
+		{name: "XML is not supported", body: `<ids><id>1</id></ids>`, args: args{dataFormat: df.XML, expr: "//id", expected: `<ids><id>1</id></ids>`}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(tt.body))})
+
+			if err := apiCtx.AssertNodeHasSameElementsAs(tt.args.dataFormat, tt.args.expr, tt.args.expected); (err != nil) != tt.wantErr {
+				t.Errorf("AssertNodeHasSameElementsAs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestState_AssertNodeMatchesRegExp(t *testing.T) {
+	mTemplateEngine := new(mockedTemplateEngine)
+	mJsonPathResolver := new(mockedJsonPathFinder)
+
+	type fields struct {
+		cacheKeys      map[string]any
+		templateEngine templateEngine
+		pathResolvers  PathFinders
+		respBody       string
+		mockFunc       func()
+	}
+	type args struct {
+		df             df.DataFormat
+		expr           string
+		regExpTemplate string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{name: "template engine error", fields: fields{
+			cacheKeys:      nil,
+			templateEngine: mTemplateEngine,
+			pathResolvers: PathFinders{
+				JSON: nil,
+				YAML: nil,
+			},
+			respBody: "",
+			mockFunc: func() {
+				mTemplateEngine.On("Replace", "abc", mock.Anything).
+					Return("", errors.New("err")).Once()
+			},
+		}, args: args{
+			df:             df.JSON,
+			expr:           "",
+			regExpTemplate: "abc",
+		}, wantErr: true},
+		{name: "json path finder error", fields: fields{
+			cacheKeys:      nil,
+			templateEngine: mTemplateEngine,
+			pathResolvers:  PathFinders{JSON: mJsonPathResolver},
+			respBody:       "",
+			mockFunc: func() {
+				mTemplateEngine.On("Replace", "abc", mock.Anything).
+					Return("abc", nil).Once()
+
+				mTemplateEngine.On("Replace", "xxx", mock.Anything).
+					Return("xxx", nil).Once()
+
+				mJsonPathResolver.On("Find", "xxx", []byte("")).
+					Return(any(""), errors.New("err")).Once()
+			},
+		}, args: args{
+			df:             df.JSON,
+			expr:           "xxx",
+			regExpTemplate: "abc",
+		}, wantErr: true},
+		{name: "node value does not match provided regExp #1", fields: fields{
+			respBody: `{
+	"name": "abcdef"
+}`,
+			mockFunc: func() {},
+		}, args: args{
+			df:             df.JSON,
+			expr:           "name",
+			regExpTemplate: "dd.*",
+		}, wantErr: true},
+
+		{name: "node value matches provided regExp #1", fields: fields{
+			respBody: `{
+	"name": "abcdef"
+}`,
+			mockFunc: func() {},
+		}, args: args{
+			df:             df.JSON,
+			expr:           "name",
+			regExpTemplate: "abc.*",
+		}, wantErr: false},
+
+		{name: "node value does not match provided regExp #2", fields: fields{
+			respBody: `---
+name: abcdef`,
+			mockFunc: func() {},
+		}, args: args{
+			df:             df.YAML,
+			expr:           "$.name",
+			regExpTemplate: "dd.*",
+		}, wantErr: true},
+
+		{name: "node value matches provided regExp #2", fields: fields{
+			respBody: `---
+name: abcdef`,
+			mockFunc: func() {},
+		}, args: args{
 			df:             df.YAML,
 			expr:           "$.name",
 			regExpTemplate: "abc.*",
@@ -4565,36 +5649,860 @@ func TestState_AssertResponseHeaderValueIs(t *testing.T) {
 			cache: map[string]any{"CONTENT_TYPE_JSON": "application/json"},
 		}, args: args{name: "Content-Type", value: "{{.CONTENT_TYPE_JSON}}"}, wantErr: false},
 	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			s := NewDefaultAPIContext(tt.fields.isDebug, "")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewDefaultAPIContext(tt.fields.isDebug, "")
+
+			s.Cache.Save(httpcache.LastHTTPResponseCacheKey, tt.fields.lastResponse)
+			if tt.fields.cache != nil {
+				for key, val := range tt.fields.cache {
+					s.Cache.Save(key, val)
+				}
+			}
+
+			if err := s.AssertResponseHeaderValueIs(tt.args.name, tt.args.value); (err != nil) != tt.wantErr {
+				t.Errorf("AssertResponseHeaderValueIs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func ExampleAPIContext_AssertResponseHeaderValueIs() {
+	apiCtx := NewDefaultAPIContext(false, "")
+
+	// instead of sending real HTTP(s) request with apiCtx.RequestSend
+	// we simply mock last HTTP(s) request's response
+	apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Header: map[string][]string{"Content-Type": {"application/json"}}})
+
+	err := apiCtx.AssertResponseHeaderValueIs("content-type", "application/json+ld")
+	fmt.Println(err)
+
+	// Output:
+	// last HTTP(s) response contains header 'content-type', but it's expected value: 'application/json+ld', is not equal to actual value: 'application/json'
+}
+
+func TestAPIContext_AssertResponseHeaderValueMatchesRegExp(t *testing.T) {
+	header := http.Header{"X-Request-Id": []string{"abc-123"}, "Link": []string{`</users?page=1>; rel="prev"`, `</users?page=3>; rel="next"`}}
+
+	tests := []struct {
+		name    string
+		header  string
+		regExp  string
+		wantErr bool
+	}{
+		{name: "value matches", header: "X-Request-Id", regExp: `^[a-z]+-\d+$`, wantErr: false},
+		{name: "value does not match", header: "X-Request-Id", regExp: `^\d+$`, wantErr: true},
+		{name: "one of repeated values matches", header: "Link", regExp: `rel="next"`, wantErr: false},
+		{name: "missing header", header: "X-Missing", regExp: `.*`, wantErr: true},
+		{name: "invalid regExp", header: "X-Request-Id", regExp: `(`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Header: header})
+
+			if err := apiCtx.AssertResponseHeaderValueMatchesRegExp(tt.header, tt.regExp); (err != nil) != tt.wantErr {
+				t.Errorf("AssertResponseHeaderValueMatchesRegExp() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAPIContext_AssertResponseHeaderValueContains(t *testing.T) {
+	header := http.Header{"Cache-Control": []string{"public, max-age=3600"}, "Set-Cookie": []string{"a=1", "b=2"}}
+
+	tests := []struct {
+		name    string
+		header  string
+		sub     string
+		wantErr bool
+	}{
+		{name: "value contains", header: "Cache-Control", sub: "max-age=3600", wantErr: false},
+		{name: "value does not contain", header: "Cache-Control", sub: "private", wantErr: true},
+		{name: "one of repeated values contains", header: "Set-Cookie", sub: "b=", wantErr: false},
+		{name: "missing header", header: "X-Missing", sub: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Header: header})
+
+			if err := apiCtx.AssertResponseHeaderValueContains(tt.header, tt.sub); (err != nil) != tt.wantErr {
+				t.Errorf("AssertResponseHeaderValueContains() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAPIContext_AssertResponseHeaderHasAllValues(t *testing.T) {
+	header := http.Header{"Vary": []string{"Accept, Origin", "Accept-Encoding"}, "Allow": []string{"GET,POST"}}
+
+	tests := []struct {
+		name    string
+		header  string
+		values  string
+		wantErr bool
+	}{
+		{name: "values from repeated and comma separated header", header: "Vary", values: "Origin, Accept-Encoding, Accept", wantErr: false},
+		{name: "missing value", header: "Vary", values: "Origin, Cookie", wantErr: true},
+		{name: "comma separated values without spaces", header: "Allow", values: "POST,GET", wantErr: false},
+		{name: "missing header", header: "X-Missing", values: "a", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Header: header})
+
+			if err := apiCtx.AssertResponseHeaderHasAllValues(tt.header, tt.values); (err != nil) != tt.wantErr {
+				t.Errorf("AssertResponseHeaderHasAllValues() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func ExampleAPIContext_AssertResponseHeaderHasAllValues() {
+	apiCtx := NewDefaultAPIContext(false, "")
+
+	// instead of sending real HTTP(s) request with apiCtx.RequestSend
+	// we simply mock last HTTP(s) request's response
+	apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Header: http.Header{"Vary": []string{"Accept", "Origin"}}})
+
+	err := apiCtx.AssertResponseHeaderHasAllValues("Vary", "Accept, Accept-Encoding, Origin")
+	fmt.Println(err)
+
+	// Output:
+	// last HTTP(s) response header 'Vary' values ["Accept" "Origin"] do not contain: ["Accept-Encoding"]
+}
+
+func TestAPIContext_AssertResponseContentTypeIs(t *testing.T) {
+	tests := []struct {
+		name        string
+		header      http.Header
+		contentType string
+		wantErr     bool
+	}{
+		{name: "equal", header: http.Header{"Content-Type": []string{"application/json"}}, contentType: "application/json", wantErr: false},
+		{name: "charset in different case", header: http.Header{"Content-Type": []string{"application/json; charset=UTF-8"}}, contentType: "application/json;charset=utf-8", wantErr: false},
+		{name: "charset not specified in expected", header: http.Header{"Content-Type": []string{"application/json; charset=utf-8"}}, contentType: "application/json", wantErr: false},
+		{name: "missing charset", header: http.Header{"Content-Type": []string{"application/json"}}, contentType: "application/json; charset=utf-8", wantErr: true},
+		{name: "different media type", header: http.Header{"Content-Type": []string{"text/html"}}, contentType: "application/json", wantErr: true},
+		{name: "invalid media type", header: http.Header{"Content-Type": []string{"application/json"}}, contentType: "application/json; charset", wantErr: true},
+		{name: "missing header", header: http.Header{}, contentType: "application/json", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Header: tt.header})
+
+			if err := apiCtx.AssertResponseContentTypeIs(tt.contentType); (err != nil) != tt.wantErr {
+				t.Errorf("AssertResponseContentTypeIs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAPIContext_AssertResponseSecurityHeaders(t *testing.T) {
+	tests := []struct {
+		name     string
+		response *http.Response
+		policy   httpctx.SecurityHeadersPolicy
+		wantErr  string
+	}{
+		{name: "missing last response", response: nil, policy: httpctx.DefaultSecurityHeadersPolicy(),
+			wantErr: "could not obtain last HTTP(s) response"},
+		{name: "policy satisfied", response: &http.Response{Header: http.Header{
+			"X-Content-Type-Options": []string{"nosniff"},
+			"Referrer-Policy":        []string{"no-referrer"},
+		}}, policy: httpctx.SecurityHeadersPolicy{RequireNoSniff: true, ReferrerPolicies: []string{"no-referrer"}}},
+		{name: "every violation is reported", response: &http.Response{Header: http.Header{
+			"Server": []string{"nginx/1.19.0"},
+		}}, policy: httpctx.DefaultSecurityHeadersPolicy(),
+			wantErr: "last HTTP(s) response violates security headers policy, found 5 violations:\n" +
+				"Strict-Transport-Security: header is missing\n" +
+				"X-Content-Type-Options: expected 'nosniff', got ''\n" +
+				"Content-Security-Policy: header is missing\n" +
+				"Referrer-Policy: header is missing\n" +
+				"Server: reveals software version: 'nginx/1.19.0'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.SetSecurityHeadersPolicy(tt.policy)
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, tt.response)
+
+			err := apiCtx.AssertResponseSecurityHeaders()
+			if tt.wantErr == "" && err != nil || tt.wantErr != "" && (err == nil || !strings.HasPrefix(err.Error(), tt.wantErr)) {
+				t.Errorf("AssertResponseSecurityHeaders() error = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAPIContext_RequestSendCORSPreflight(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodOptions || r.Header.Get("Origin") != "https://app.example.com" ||
+			r.Header.Get("Access-Control-Request-Method") != http.MethodPut ||
+			r.Header.Get("Access-Control-Request-Headers") != "content-type,x-request-id" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Access-Control-Allow-Origin", r.Header.Get("Origin"))
+		w.Header().Set("Access-Control-Allow-Methods", "PUT, DELETE")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, X-Request-Id")
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+		w.Header().Set("Access-Control-Max-Age", "600")
+		w.Header().Set("Vary", "Origin")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	apiCtx := NewDefaultAPIContext(false, "")
+	apiCtx.Cache.Save("URL", server.URL)
+	apiCtx.Cache.Save("ORIGIN", "https://app.example.com")
+
+	if err := apiCtx.RequestSendCORSPreflight("{{.URL}}/users", "{{.ORIGIN}}", http.MethodPut, "Content-Type, X-Request-Id"); err != nil {
+		t.Fatalf("RequestSendCORSPreflight() error = %v", err)
+	}
+
+	if err := apiCtx.AssertStatusCodeIs(http.StatusNoContent); err != nil {
+		t.Fatalf("preflight request was not prepared properly, err: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		assert  func() error
+		wantErr bool
+	}{
+		{name: "allowed origin", assert: func() error { return apiCtx.AssertResponseCORSAllowsOrigin("{{.ORIGIN}}") }},
+		{name: "not allowed origin", assert: func() error { return apiCtx.AssertResponseCORSAllowsOrigin("https://evil.com") }, wantErr: true},
+		{name: "allowed methods", assert: func() error { return apiCtx.AssertResponseCORSAllowsMethods("PUT, DELETE, GET") }},
+		{name: "not allowed method", assert: func() error { return apiCtx.AssertResponseCORSAllowsMethods("PATCH") }, wantErr: true},
+		{name: "allowed headers", assert: func() error { return apiCtx.AssertResponseCORSAllowsHeaders("content-type") }},
+		{name: "not allowed header", assert: func() error { return apiCtx.AssertResponseCORSAllowsHeaders("Authorization") }, wantErr: true},
+		{name: "allowed credentials", assert: func() error { return apiCtx.AssertResponseCORSAllowsCredentials() }},
+		{name: "max age", assert: func() error { return apiCtx.AssertResponseCORSMaxAgeIsAtLeast(5 * time.Minute) }},
+		{name: "max age too short", assert: func() error { return apiCtx.AssertResponseCORSMaxAgeIsAtLeast(time.Hour) }, wantErr: true},
+		{name: "varies by origin", assert: func() error { return apiCtx.AssertResponseCORSVariesByOrigin() }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.assert(); (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAPIContext_RequestSendConditional(t *testing.T) {
+	lastModified := time.Date(2022, 3, 4, 10, 0, 0, 0, time.UTC).Format(http.TimeFormat)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "private, max-age=60")
+		if r.URL.Path == "/etag" {
+			w.Header().Set("ETag", `"v1"`)
+		} else {
+			w.Header().Set("Last-Modified", lastModified)
+		}
+
+		if r.Header.Get("If-None-Match") == `"v1"` || r.Header.Get("If-Modified-Since") == lastModified {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		path    string
+		send    func(apiCtx *APIContext) error
+		wantErr bool
+	}{
+		{name: "if none match", path: "/etag", send: func(apiCtx *APIContext) error { return apiCtx.RequestSendIfNoneMatch("REQ") }},
+		{name: "if modified since", path: "/date", send: func(apiCtx *APIContext) error { return apiCtx.RequestSendIfModifiedSince("REQ") }},
+		{name: "missing ETag", path: "/date", send: func(apiCtx *APIContext) error { return apiCtx.RequestSendIfNoneMatch("REQ") }, wantErr: true},
+		{name: "missing prepared request", path: "/etag", send: func(apiCtx *APIContext) error { return apiCtx.RequestSendIfNoneMatch("OTHER") }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			if err := apiCtx.RequestPrepare(http.MethodGet, server.URL+tt.path, "REQ"); err != nil {
+				t.Fatalf("RequestPrepare() error = %v", err)
+			}
+
+			if err := apiCtx.RequestSend("REQ"); err != nil {
+				t.Fatalf("RequestSend() error = %v", err)
+			}
+
+			if err := apiCtx.AssertResponseIsNotModified(); err == nil {
+				t.Errorf("AssertResponseIsNotModified() expected error for first response")
+			}
+
+			err := tt.send(apiCtx)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if err = apiCtx.AssertResponseIsNotModified(); err != nil {
+				t.Errorf("AssertResponseIsNotModified() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestAPIContext_AssertCacheControl(t *testing.T) {
+	tests := []struct {
+		name         string
+		cacheControl []string
+		assert       func(apiCtx *APIContext) error
+		wantErr      bool
+	}{
+		{name: "directive exists", cacheControl: []string{"Private, max-age=60"}, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertCacheControlDirectiveExists("private")
+		}},
+		{name: "directive does not exist", cacheControl: []string{"private, max-age=60"}, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertCacheControlDirectiveExists("no-store")
+		}, wantErr: true},
+		{name: "directive not exists", cacheControl: []string{"no-store"}, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertCacheControlDirectiveNotExists("public")
+		}},
+		{name: "directive unexpectedly exists", cacheControl: []string{"public", "max-age=60"}, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertCacheControlDirectiveNotExists("PUBLIC")
+		}, wantErr: true},
+		{name: "max-age in range", cacheControl: []string{"public, max-age=60, s-maxage=3600"}, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertCacheControlMaxAgeIsBetween("s-maxage", time.Minute, time.Hour)
+		}},
+		{name: "max-age out of range", cacheControl: []string{"max-age=60"}, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertCacheControlMaxAgeIsBetween("max-age", 2*time.Minute, time.Hour)
+		}, wantErr: true},
+		{name: "max-age missing", cacheControl: []string{"no-store"}, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertCacheControlMaxAgeIsBetween("max-age", 0, time.Hour)
+		}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Header: http.Header{"Cache-Control": tt.cacheControl}})
+
+			if err := tt.assert(apiCtx); (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAPIContext_AssertResponseIsProblemDetails(t *testing.T) {
+	problem := `{"type": "https://example.com/probs/out-of-credit", "title": "You do not have enough credit.", "status": 403,
+		"detail": "Your current balance is 30, but that costs 50.", "instance": "/account/12345/msgs/abc", "balance": 30}`
+
+	tests := []struct {
+		name        string
+		statusCode  int
+		contentType string
+		body        string
+		problemType string
+		extensions  string
+		wantErr     bool
+	}{
+		{name: "valid problem details", statusCode: 403, contentType: "application/problem+json", body: problem,
+			problemType: "https://example.com/probs/out-of-credit", extensions: "balance"},
+		{name: "default type", statusCode: 404, contentType: "application/problem+json; charset=utf-8", body: `{"title": "Not Found"}`,
+			problemType: "about:blank"},
+		{name: "wrong content type", statusCode: 403, contentType: "application/json", body: problem, wantErr: true},
+		{name: "member of wrong type", statusCode: 403, contentType: "application/problem+json", body: `{"title": 1}`, wantErr: true},
+		{name: "status different than response status code", statusCode: 400, contentType: "application/problem+json", body: problem, wantErr: true},
+		{name: "different type", statusCode: 403, contentType: "application/problem+json", body: problem,
+			problemType: "https://example.com/probs/other", wantErr: true},
+		{name: "missing extension", statusCode: 403, contentType: "application/problem+json", body: problem,
+			extensions: "balance, accounts", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{
+				StatusCode: tt.statusCode,
+				Header:     http.Header{"Content-Type": []string{tt.contentType}},
+				Body:       io.NopCloser(strings.NewReader(tt.body)),
+			})
+
+			if err := apiCtx.AssertResponseIsProblemDetails(tt.problemType, tt.extensions); (err != nil) != tt.wantErr {
+				t.Errorf("AssertResponseIsProblemDetails() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAPIContext_RequestSendPaginated(t *testing.T) {
+	// server holds 5 items, returned by 2 per page
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := 0
+		query := r.URL.Query()
+		switch r.URL.Path {
+		case "/link", "/cursor":
+			start, _ = strconv.Atoi(query.Get("from"))
+			if start == 0 {
+				start, _ = strconv.Atoi(query.Get("cursor"))
+			}
+		case "/page":
+			page, _ := strconv.Atoi(query.Get("page"))
+			start = (page - 1) * 2
+		case "/offset":
+			start, _ = strconv.Atoi(query.Get("offset"))
+		case "/loop":
+			w.Header().Set("Link", `</loop>; rel="next"`)
+		case "/error":
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		ids := make([]string, 0)
+		for id := start + 1; id <= start+2 && id <= 5; id++ {
+			ids = append(ids, fmt.Sprintf(`{"id": %d}`, id))
+		}
+
+		cursor := "null"
+		if start+2 < 5 {
+			cursor = strconv.Itoa(start + 2)
+			if r.URL.Path == "/link" {
+				w.Header().Set("Link", fmt.Sprintf(`</link?from=%d>; rel="next"`, start+2))
+			}
+		}
+
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"data": [%s], "meta": {"next": %s}}`, strings.Join(ids, ", "), cursor)))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		path    string
+		send    func(apiCtx *APIContext) error
+		wantIDs []float64
+		wantErr bool
+	}{
+		{name: "by link", path: "/link", send: func(apiCtx *APIContext) error {
+			return apiCtx.RequestSendPaginatedByLink("REQ", df.JSON, "data", 0, "ITEMS")
+		}, wantIDs: []float64{1, 2, 3, 4, 5}},
+		{name: "by link with max pages", path: "/link", send: func(apiCtx *APIContext) error {
+			return apiCtx.RequestSendPaginatedByLink("REQ", df.JSON, "data", 2, "ITEMS")
+		}, wantIDs: []float64{1, 2, 3, 4}},
+		{name: "by cursor", path: "/cursor", send: func(apiCtx *APIContext) error {
+			return apiCtx.RequestSendPaginatedByCursor("REQ", df.JSON, "data", "meta.next", "cursor", 0, "ITEMS")
+		}, wantIDs: []float64{1, 2, 3, 4, 5}},
+		{name: "by cursor without cursor node", path: "/cursor", send: func(apiCtx *APIContext) error {
+			return apiCtx.RequestSendPaginatedByCursor("REQ", df.JSON, "data", "meta.missing", "cursor", 0, "ITEMS")
+		}, wantIDs: []float64{1, 2}},
+		{name: "by page", path: "/page?page=1", send: func(apiCtx *APIContext) error {
+			return apiCtx.RequestSendPaginatedByPage("REQ", df.JSON, "data", "page", 0, "ITEMS")
+		}, wantIDs: []float64{1, 2, 3, 4, 5}},
+		{name: "by offset", path: "/offset", send: func(apiCtx *APIContext) error {
+			return apiCtx.RequestSendPaginatedByOffset("REQ", df.JSON, "data", "offset", 0, "ITEMS")
+		}, wantIDs: []float64{1, 2, 3, 4, 5}},
+		{name: "link pointing at fetched page", path: "/loop", send: func(apiCtx *APIContext) error {
+			return apiCtx.RequestSendPaginatedByLink("REQ", df.JSON, "data", 0, "ITEMS")
+		}, wantErr: true},
+		{name: "page with error status code", path: "/error", send: func(apiCtx *APIContext) error {
+			return apiCtx.RequestSendPaginatedByLink("REQ", df.JSON, "data", 0, "ITEMS")
+		}, wantErr: true},
+		{name: "items node is not array", path: "/link", send: func(apiCtx *APIContext) error {
+			return apiCtx.RequestSendPaginatedByLink("REQ", df.JSON, "meta", 0, "ITEMS")
+		}, wantErr: true},
+		{name: "unsupported format", path: "/link", send: func(apiCtx *APIContext) error {
+			return apiCtx.RequestSendPaginatedByLink("REQ", df.XML, "data", 0, "ITEMS")
+		}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			if err := apiCtx.RequestPrepare(http.MethodGet, server.URL+tt.path, "REQ"); err != nil {
+				t.Fatalf("RequestPrepare() error = %v", err)
+			}
+
+			err := tt.send(apiCtx)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			items, err := apiCtx.Cache.GetSaved("ITEMS")
+			if err != nil {
+				t.Fatalf("items were not saved, err: %v", err)
+			}
+
+			ids := make([]float64, 0)
+			for _, item := range items.([]any) {
+				ids = append(ids, item.(map[string]any)["id"].(float64))
+			}
+
+			if !reflect.DeepEqual(ids, tt.wantIDs) {
+				t.Errorf("collected ids = %v, want %v", ids, tt.wantIDs)
+			}
+
+			if err = apiCtx.AssertNodeExists(df.JSON, "meta"); err != nil {
+				t.Errorf("last HTTP(s) response should be response with last page, err: %v", err)
+			}
+
+			if err = apiCtx.SetCachedDocumentAsNodeSource("ITEMS"); err != nil {
+				t.Fatalf("SetCachedDocumentAsNodeSource() error = %v", err)
+			}
+
+			if err = apiCtx.AssertNodeSliceLengthIs(df.JSON, "$[*]", len(tt.wantIDs)); err != nil {
+				t.Errorf("collected items should be checked with node assertions, err: %v", err)
+			}
+
+			if err = apiCtx.AssertNodeElementsAreUnique(df.JSON, "$[*]", "id"); err != nil {
+				t.Errorf("collected items should be checked with collection assertions, err: %v", err)
+			}
+		})
+	}
+}
+
+func TestAPIContext_RequestSendConcurrently(t *testing.T) {
+	var mu sync.Mutex
+	created := make(map[string]bool)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if len(body) == 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if created[string(body)] {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		created[string(body)] = true
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	apiCtx := NewDefaultAPIContext(false, "")
+	if err := apiCtx.AssertResponsesStatusCodeCountIs(http.StatusCreated, 1); err == nil {
+		t.Errorf("AssertResponsesStatusCodeCountIs() expected error when no concurrent requests were sent")
+	}
+
+	if err := apiCtx.RequestPrepare(http.MethodPost, server.URL, "REQ"); err != nil {
+		t.Fatalf("RequestPrepare() error = %v", err)
+	}
+
+	if err := apiCtx.RequestSetBody("REQ", `{"name": "abc"}`); err != nil {
+		t.Fatalf("RequestSetBody() error = %v", err)
+	}
+
+	if err := apiCtx.RequestSendConcurrently("REQ", 0); err == nil {
+		t.Errorf("RequestSendConcurrently() expected error for 0 requests")
+	}
+
+	if err := apiCtx.RequestSendConcurrently("REQ", 20); err != nil {
+		t.Fatalf("RequestSendConcurrently() error = %v", err)
+	}
+
+	responses, err := apiCtx.GetLastResponses()
+	if err != nil || len(responses) != 20 {
+		t.Fatalf("GetLastResponses() got %d responses, err: %v", len(responses), err)
+	}
+
+	tests := []struct {
+		name    string
+		assert  func() error
+		wantErr bool
+	}{
+		{name: "distribution", assert: func() error { return apiCtx.AssertResponsesStatusCodesAre("201: 1, 409: 19") }},
+		{name: "different distribution", assert: func() error { return apiCtx.AssertResponsesStatusCodesAre("201: 20") }, wantErr: true},
+		{name: "invalid distribution", assert: func() error { return apiCtx.AssertResponsesStatusCodesAre("201") }, wantErr: true},
+		{name: "exactly one created", assert: func() error { return apiCtx.AssertResponsesStatusCodeCountIs(http.StatusCreated, 1) }},
+		{name: "none bad request", assert: func() error { return apiCtx.AssertResponsesStatusCodeCountIs(http.StatusBadRequest, 0) }},
+		{name: "wrong count", assert: func() error { return apiCtx.AssertResponsesStatusCodeCountIs(http.StatusConflict, 20) }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.assert(); (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if err = apiCtx.RequestSend("REQ"); err != nil || apiCtx.AssertStatusCodeIs(http.StatusConflict) != nil {
+		t.Errorf("prepared request body should be still readable, err: %v", err)
+	}
+}
+
+func TestAPIContext_RequestSendWithLoadProfile(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"name": "abc"}` {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if atomic.AddInt32(&calls, 1)%10 == 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		time.Sleep(time.Millisecond)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	apiCtx := NewDefaultAPIContext(false, "")
+	if err := apiCtx.AssertLoadErrorRateIsAtMost("SUMMARY", 0.1); err == nil {
+		t.Errorf("AssertLoadErrorRateIsAtMost() expected error when no summary was saved")
+	}
+
+	if err := apiCtx.RequestPrepare(http.MethodPost, server.URL, "REQ"); err != nil {
+		t.Fatalf("RequestPrepare() error = %v", err)
+	}
+
+	if err := apiCtx.RequestSetBody("REQ", `{"name": "abc"}`); err != nil {
+		t.Fatalf("RequestSetBody() error = %v", err)
+	}
+
+	for _, profile := range []string{`{"concurrency": 2}`, `{"iterations": -1}`, `<profile></profile>`, `{"duration": 10}`} {
+		if err := apiCtx.RequestSendWithLoadProfile("REQ", profile, "SUMMARY"); err == nil {
+			t.Errorf("RequestSendWithLoadProfile() expected error for profile %s", profile)
+		}
+	}
+
+	if err := apiCtx.RequestSendWithLoadProfile("REQ", "concurrency: 4\nrate: 1000\nduration: 10s\niterations: 40", "SUMMARY"); err != nil {
+		t.Fatalf("RequestSendWithLoadProfile() error = %v", err)
+	}
+
+	if _, err := apiCtx.GetLastResponse(); err == nil {
+		t.Errorf("RequestSendWithLoadProfile() should not save last response")
+	}
+
+	summary, err := apiCtx.getLoadSummary("SUMMARY")
+	if err != nil || summary.Requests != 40 || summary.Errors != 4 || summary.StatusCodes[http.StatusCreated] != 36 {
+		t.Fatalf("getLoadSummary() got %s, status codes: %v, err: %v", summary, summary.StatusCodes, err)
+	}
+
+	p95, err := apiCtx.TemplateEngine.Replace("{{.SUMMARY.P95}}", apiCtx.Cache.All())
+	if err != nil || p95 != summary.P95.String() {
+		t.Errorf("summary P95 in template got %s, err: %v, want %s", p95, err, summary.P95)
+	}
+
+	tests := []struct {
+		name    string
+		assert  func() error
+		wantErr bool
+	}{
+		{name: "p99 latency", assert: func() error { return apiCtx.AssertLoadLatencyPercentileIsAtMost("SUMMARY", 99, 5*time.Second) }},
+		{name: "p50 latency too high", assert: func() error { return apiCtx.AssertLoadLatencyPercentileIsAtMost("SUMMARY", 50, time.Nanosecond) }, wantErr: true},
+		{name: "invalid percentile", assert: func() error { return apiCtx.AssertLoadLatencyPercentileIsAtMost("SUMMARY", 0, time.Second) }, wantErr: true},
+		{name: "error rate", assert: func() error { return apiCtx.AssertLoadErrorRateIsAtMost("SUMMARY", 0.1) }},
+		{name: "error rate too high", assert: func() error { return apiCtx.AssertLoadErrorRateIsAtMost("SUMMARY", 0.05) }, wantErr: true},
+		{name: "invalid error rate", assert: func() error { return apiCtx.AssertLoadErrorRateIsAtMost("SUMMARY", 5) }, wantErr: true},
+		{name: "throughput", assert: func() error { return apiCtx.AssertLoadThroughputIsAtLeast("SUMMARY", 1) }},
+		{name: "throughput too low", assert: func() error { return apiCtx.AssertLoadThroughputIsAtLeast("SUMMARY", 1e9) }, wantErr: true},
+		{name: "not summary", assert: func() error { return apiCtx.AssertLoadThroughputIsAtLeast("REQ", 1) }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.assert(); (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAPIContext_AssertResponseTimingPhaseIsAtMost(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(20 * time.Millisecond)
+		w.Write([]byte(`{"name": "abc"}`))
+	}))
+	defer server.Close()
+
+	apiCtx := NewDefaultAPIContext(false, "")
+	if err := apiCtx.AssertResponseTimingPhaseIsAtMost("Total", time.Minute); err == nil {
+		t.Errorf("AssertResponseTimingPhaseIsAtMost() expected error when no request was sent")
+	}
+
+	if err := apiCtx.RequestPrepare(http.MethodGet, server.URL, "REQ"); err != nil {
+		t.Fatalf("RequestPrepare() error = %v", err)
+	}
+
+	if err := apiCtx.RequestSend("REQ"); err != nil {
+		t.Fatalf("RequestSend() error = %v", err)
+	}
+
+	timing, err := apiCtx.GetLastResponseTiming()
+	if err != nil || timing.ServerProcessing < 20*time.Millisecond || timing.Total < timing.TimeToFirstByte {
+		t.Fatalf("GetLastResponseTiming() got %s, err: %v", timing, err)
+	}
+
+	if err = apiCtx.AssertNodeIsTypeAndValue(df.JSON, "name", types.String, "abc"); err != nil {
+		t.Errorf("last response body should be readable after timing was recorded, err: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		phase   string
+		max     time.Duration
+		wantErr bool
+	}{
+		{name: "total", phase: "Total", max: time.Minute},
+		{name: "case-insensitive phase", phase: "tlshandshake", max: 0},
+		{name: "slow server processing", phase: "ServerProcessing", max: 10 * time.Millisecond, wantErr: true},
+		{name: "time to first byte", phase: "TimeToFirstByte", max: time.Millisecond, wantErr: true},
+		{name: "unknown phase", phase: "TLS", max: time.Minute, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := apiCtx.AssertResponseTimingPhaseIsAtMost(tt.phase, tt.max); (err != nil) != tt.wantErr {
+				t.Errorf("AssertResponseTimingPhaseIsAtMost() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAPIContext_ResponseBodyOptions(t *testing.T) {
+	body := `{"name": "abc", "items": [1, 2, 3]}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/chunked" {
+			w.Write([]byte(body))
+			w.(http.Flusher).Flush()
+			return
+		}
+
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	apiCtx := NewDefaultAPIContext(false, "")
+	if err := apiCtx.AssertResponseBodySizeIs(len(body)); err == nil {
+		t.Errorf("AssertResponseBodySizeIs() expected error when no request was sent")
+	}
+
+	if err := apiCtx.RequestPrepare(http.MethodGet, server.URL, "REQ"); err != nil {
+		t.Fatalf("RequestPrepare() error = %v", err)
+	}
 
-			s.Cache.Save(httpcache.LastHTTPResponseCacheKey, tt.fields.lastResponse)
-			if tt.fields.cache != nil {
-				for key, val := range tt.fields.cache {
-					s.Cache.Save(key, val)
-				}
-			}
+	if err := apiCtx.RequestPrepare(http.MethodGet, server.URL+"/chunked", "CHUNKED"); err != nil {
+		t.Fatalf("RequestPrepare() error = %v", err)
+	}
 
-			if err := s.AssertResponseHeaderValueIs(tt.args.name, tt.args.value); (err != nil) != tt.wantErr {
-				t.Errorf("AssertResponseHeaderValueIs() error = %v, wantErr %v", err, tt.wantErr)
+	apiCtx.SetResponseBodyOptions(httpctx.ResponseBodyOptions{MaxSize: int64(len(body) - 1)})
+	err := apiCtx.RequestSend("REQ")
+	var tooLargeErr *httpctx.BodyTooLargeError
+	if !errors.As(err, &tooLargeErr) {
+		t.Errorf("RequestSend() error = %v, want BodyTooLargeError", err)
+	}
+
+	tempDir := t.TempDir()
+	apiCtx.SetResponseBodyOptions(httpctx.ResponseBodyOptions{MaxSize: int64(len(body)), StreamToFile: true, TempDir: tempDir})
+	if err = apiCtx.RequestSend("REQ"); err != nil {
+		t.Fatalf("RequestSend() error = %v", err)
+	}
+
+	lastResp, _ := apiCtx.GetLastResponse()
+	fileBody, ok := lastResp.Body.(*httpctx.FileBody)
+	if !ok {
+		t.Fatalf("last response body should be written to file, got %T", lastResp.Body)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err = apiCtx.AssertNodeIsTypeAndValue(df.JSON, "name", types.String, "abc"); err != nil {
+			t.Errorf("body written to file should be readable many times, err: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		assert  func() error
+		wantErr bool
+	}{
+		{name: "content length", assert: func() error { return apiCtx.AssertResponseContentLengthIs(len(body)) }},
+		{name: "different content length", assert: func() error { return apiCtx.AssertResponseContentLengthIs(1) }, wantErr: true},
+		{name: "body size", assert: func() error { return apiCtx.AssertResponseBodySizeIs(len(body)) }},
+		{name: "different body size", assert: func() error { return apiCtx.AssertResponseBodySizeIs(len(body) + 1) }, wantErr: true},
+		{name: "body size at most", assert: func() error { return apiCtx.AssertResponseBodySizeIsAtMost(len(body)) }},
+		{name: "body size too large", assert: func() error { return apiCtx.AssertResponseBodySizeIsAtMost(len(body) - 1) }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.assert(); (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
-}
 
-func ExampleAPIContext_AssertResponseHeaderValueIs() {
-	apiCtx := NewDefaultAPIContext(false, "")
+	limit := int64(len(body) - 1)
+	apiCtx.SetResponseBodyOptions(httpctx.ResponseBodyOptions{StreamToFile: true, MaxInMemorySize: limit, TempDir: tempDir})
+	if err = apiCtx.RequestSend("REQ"); err != nil {
+		t.Fatalf("RequestSend() error = %v", err)
+	}
 
-	// instead of sending real HTTP(s) request with apiCtx.RequestSend
-	// we simply mock last HTTP(s) request's response
-	apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Header: map[string][]string{"Content-Type": {"application/json"}}})
+	lastResp, _ = apiCtx.GetLastResponse()
+	largeBody, ok := lastResp.Body.(*httpctx.FileBody)
+	if !ok {
+		t.Fatalf("last response body should be written to file, got %T", lastResp.Body)
+	}
 
-	err := apiCtx.AssertResponseHeaderValueIs("content-type", "application/json+ld")
-	fmt.Println(err)
+	if _, err = apiCtx.GetLastResponseBody(); !errors.As(err, &tooLargeErr) || tooLargeErr.MaxSize != limit {
+		t.Errorf("GetLastResponseBody() error = %v, want BodyTooLargeError for body larger than in memory limit", err)
+	}
 
-	// Output:
-	// last HTTP(s) response contains header 'content-type', but it's expected value: 'application/json+ld', is not equal to actual value: 'application/json'
+	if err = apiCtx.AssertNodeExists(df.JSON, "name"); !errors.As(err, &tooLargeErr) {
+		t.Errorf("AssertNodeExists() error = %v, want BodyTooLargeError for body larger than in memory limit", err)
+	}
+
+	apiCtx.SetDebugger(debugger.New(false, false, 5, io.Discard))
+	wantSnippet := fmt.Sprintf("response body (first 5 of %d bytes): %s", len(body), body[:5])
+	if err = apiCtx.AssertStatusCodeIs(201); err == nil || !strings.HasSuffix(err.Error(), wantSnippet) {
+		t.Errorf("AssertStatusCodeIs() error = %v, should end with %s", err, wantSnippet)
+	}
+
+	// body larger than limit is not loaded, so it is still read from the beginning of file
+	if data, _ := io.ReadAll(largeBody); string(data) != body {
+		t.Errorf("body larger than in memory limit should not be read, got = %s", data)
+	}
+
+	largeBody.Close()
+
+	apiCtx.SetResponseBodyOptions(httpctx.ResponseBodyOptions{})
+	if err = apiCtx.RequestSend("CHUNKED"); err != nil {
+		t.Fatalf("RequestSend() error = %v", err)
+	}
+
+	if _, err = os.Stat(fileBody.Path); !os.IsNotExist(err) {
+		t.Errorf("temporary file of previous response body should be deleted, err: %v", err)
+	}
+
+	if err = apiCtx.AssertResponseBodySizeIs(len(body)); err != nil {
+		t.Errorf("AssertResponseBodySizeIs() error = %v", err)
+	}
+
+	if err = apiCtx.AssertResponseContentLengthIs(len(body)); err == nil {
+		t.Errorf("AssertResponseContentLengthIs() expected error for response with unknown content length")
+	}
+
+	apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{ContentLength: 5, Header: http.Header{}, Body: io.NopCloser(strings.NewReader("abcde"))})
+	if err = apiCtx.AssertResponseContentLengthIs(5); err != nil {
+		t.Errorf("AssertResponseContentLengthIs() should use response content length, err: %v", err)
+	}
+
+	apiCtx.SetResponseBodyOptions(httpctx.ResponseBodyOptions{StreamToFile: true, TempDir: tempDir})
+	if err = apiCtx.RequestSend("REQ"); err != nil {
+		t.Fatalf("RequestSend() error = %v", err)
+	}
+
+	apiCtx.ResetState(false)
+	if entries, _ := os.ReadDir(tempDir); len(entries) != 0 {
+		t.Errorf("ResetState() should delete temporary file of last response body, got %d files", len(entries))
+	}
 }
 
 func TestState_AssertResponseMatchesSchemaByReference(t *testing.T) {
@@ -5117,6 +7025,192 @@ func TestAPIContext_AssertResponseCookieNotExists(t *testing.T) {
 	}
 }
 
+func TestAPIContext_AssertResponseCookieAttributes(t *testing.T) {
+	strict := "session=abc; Path=/api; Domain=example.com; Max-Age=3600; Secure; HttpOnly; SameSite=Strict"
+	plain := "session=abc"
+
+	tests := []struct {
+		name      string
+		setCookie string
+		assert    func(apiCtx *APIContext) error
+		wantErr   bool
+	}{
+		{name: "missing cookie", setCookie: strict, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertResponseCookieIsSecure("token")
+		}, wantErr: true},
+		{name: "secure", setCookie: strict, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertResponseCookieIsSecure("session")
+		}},
+		{name: "not secure", setCookie: plain, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertResponseCookieIsSecure("session")
+		}, wantErr: true},
+		{name: "http only", setCookie: strict, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertResponseCookieIsHttpOnly("session")
+		}},
+		{name: "not http only", setCookie: plain, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertResponseCookieIsHttpOnly("session")
+		}, wantErr: true},
+		{name: "same site case-insensitive", setCookie: strict, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertResponseCookieSameSiteIs("session", "strict")
+		}},
+		{name: "same site differs", setCookie: strict, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertResponseCookieSameSiteIs("session", "Lax")
+		}, wantErr: true},
+		{name: "same site not set", setCookie: plain, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertResponseCookieSameSiteIs("session", "None")
+		}, wantErr: true},
+		{name: "path", setCookie: strict, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertResponseCookiePathIs("session", "/api")
+		}},
+		{name: "path differs", setCookie: strict, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertResponseCookiePathIs("session", "/")
+		}, wantErr: true},
+		{name: "domain with leading dot", setCookie: strict, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertResponseCookieDomainIs("session", ".example.com")
+		}},
+		{name: "domain differs", setCookie: strict, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertResponseCookieDomainIs("session", "example.org")
+		}, wantErr: true},
+		{name: "max age within interval", setCookie: strict, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertResponseCookieExpiresWithin("session", 2*time.Hour)
+		}},
+		{name: "max age exceeds interval", setCookie: strict, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertResponseCookieExpiresWithin("session", 30*time.Minute)
+		}, wantErr: true},
+		{name: "expires within interval", setCookie: "session=abc; Expires=" + time.Now().Add(time.Hour).UTC().Format(http.TimeFormat),
+			assert: func(apiCtx *APIContext) error {
+				return apiCtx.AssertResponseCookieExpiresWithin("session", 2*time.Hour)
+			}},
+		{name: "session cookie", setCookie: plain, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertResponseCookieExpiresWithin("session", 2*time.Hour)
+		}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{
+				Header: http.Header{"Set-Cookie": []string{tt.setCookie}},
+			})
+
+			if err := tt.assert(apiCtx); (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAPIContext_AssertAll(t *testing.T) {
+	body := `{"id": 1, "name": "abc"}`
+
+	t.Run("assertions return failures when soft assertion mode is off", func(t *testing.T) {
+		apiCtx := NewDefaultAPIContext(false, "")
+		apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body))})
+
+		if err := apiCtx.AssertStatusCodeIs(201); err == nil {
+			t.Errorf("AssertStatusCodeIs() should return error when soft assertion mode is off")
+		}
+
+		if err := apiCtx.AssertAll(); err != nil {
+			t.Errorf("AssertAll() error = %v, failures should not be recorded when soft assertion mode is off", err)
+		}
+	})
+
+	t.Run("assertions record failures when soft assertion mode is on", func(t *testing.T) {
+		apiCtx := NewDefaultAPIContext(false, "")
+		apiCtx.SetSoftAssertionMode(true)
+		apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body))})
+
+		if err := apiCtx.AssertStatusCodeIs(201); err != nil {
+			t.Errorf("AssertStatusCodeIs() error = %v, failure should be recorded", err)
+		}
+
+		if err := apiCtx.AssertNodeIsTypeAndValue(df.JSON, "name", types.String, "abc"); err != nil {
+			t.Errorf("AssertNodeIsTypeAndValue() error = %v, wantErr false", err)
+		}
+
+		if err := apiCtx.AssertNodeIsTypeAndValue(df.JSON, "id", types.Number, "2"); err != nil {
+			t.Errorf("AssertNodeIsTypeAndValue() error = %v, failure should be recorded", err)
+		}
+
+		err := apiCtx.AssertAll()
+		if err == nil {
+			t.Fatalf("AssertAll() should report recorded failures")
+		}
+
+		for _, want := range []string{"2 soft assertions failed", "1) AssertStatusCodeIs(201)", "2) AssertNodeIsTypeAndValue(json, id, number, 2)"} {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("AssertAll() error = %v, should contain %s", err, want)
+			}
+		}
+
+		if err = apiCtx.AssertAll(); err != nil {
+			t.Errorf("AssertAll() error = %v, recorded failures should be cleared", err)
+		}
+	})
+
+	t.Run("ResetState clears recorded failures", func(t *testing.T) {
+		apiCtx := NewDefaultAPIContext(false, "")
+		apiCtx.SetSoftAssertionMode(true)
+		apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body))})
+
+		_ = apiCtx.AssertStatusCodeIs(201)
+		apiCtx.ResetState(false)
+
+		if err := apiCtx.AssertAll(); err != nil {
+			t.Errorf("AssertAll() error = %v, ResetState should clear recorded failures", err)
+		}
+
+		if apiCtx.isSoftAssertionMode {
+			t.Errorf("ResetState should turn off soft assertion mode")
+		}
+	})
+
+	t.Run("setup failures are not softened", func(t *testing.T) {
+		apiCtx := NewDefaultAPIContext(false, "")
+		apiCtx.SetSoftAssertionMode(true)
+
+		if err := apiCtx.AssertStatusCodeIs(201); err == nil {
+			t.Errorf("AssertStatusCodeIs() should return error when there is no last response")
+		}
+
+		apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body))})
+
+		if err := apiCtx.AssertResponseFormatIs("unknown"); err == nil {
+			t.Errorf("AssertResponseFormatIs() should return error for unknown data format")
+		}
+
+		if err := apiCtx.AssertNodeEqualsCached(df.JSON, "id", "missing"); err == nil {
+			t.Errorf("AssertNodeEqualsCached() should return error when cache key is missing")
+		}
+
+		if err := apiCtx.AssertAll(); err != nil {
+			t.Errorf("AssertAll() error = %v, setup failures should not be recorded", err)
+		}
+	})
+}
+
+func ExampleAPIContext_AssertAll() {
+	apiCtx := NewDefaultAPIContext(false, "")
+	apiCtx.SetSoftAssertionMode(true)
+
+	// instead of sending real HTTP(s) request with apiCtx.RequestSend
+	// we simply mock last HTTP(s) request's response
+	apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(`{"id": 1}`))})
+
+	fmt.Println(apiCtx.AssertStatusCodeIs(201))
+	fmt.Println(apiCtx.AssertNodeNumberCompare(df.JSON, "id", mathutils.OperatorGreater, "1"))
+	fmt.Println(apiCtx.AssertAll())
+
+	// Output:
+	// <nil>
+	// <nil>
+	// 2 soft assertions failed:
+	// 1) AssertStatusCodeIs(201)
+	//    expected status code 201, but got 200, response body: {"id": 1}
+	// 2) AssertNodeNumberCompare(json, id, >, 1)
+	//    node 'id' has value 1, which does not satisfy condition: > 1
+}
+
 func TestState_Save(t *testing.T) {
 	type fields struct {
 		cacheData map[string]any
@@ -5381,6 +7475,32 @@ func ExampleAPIContext_SaveNode() {
 	// abc
 }
 
+func TestAPIContext_SaveNodeTime(t *testing.T) {
+	apiCtx := NewDefaultAPIContext(false, "")
+	apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Body: io.NopCloser(strings.NewReader(`{"createdAt": "2022-03-04T10:20:30+01:00", "name": "abc"}`))})
+
+	if err := apiCtx.SaveNodeTime(df.JSON, "createdAt", "", "CREATED_AT"); err != nil {
+		t.Fatalf("SaveNodeTime() error = %v", err)
+	}
+
+	saved, err := apiCtx.getCachedTime("CREATED_AT")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !saved.Equal(time.Date(2022, 3, 4, 9, 20, 30, 0, time.UTC)) {
+		t.Errorf("SaveNodeTime() saved %v", saved)
+	}
+
+	if err = apiCtx.SaveNodeTime(df.JSON, "name", "", "NAME"); err == nil {
+		t.Errorf("SaveNodeTime() expected error for node that is not time")
+	}
+
+	if err = apiCtx.SaveNodeTime(df.JSON, "createdAt", "", ""); err == nil {
+		t.Errorf("SaveNodeTime() expected error for empty cache key")
+	}
+}
+
 func TestAPIContext_SaveHeader(t *testing.T) {
 	type fields struct {
 		resp *http.Response
@@ -5457,3 +7577,270 @@ func ExampleAPIContext_SaveHeader() {
 	// Output:
 	// application/json
 }
+
+func TestAPIContext_SaveHeaderRegExpMatch(t *testing.T) {
+	header := http.Header{"Cache-Control": []string{"public, max-age=3600"}, "Link": []string{`</users?page=1>; rel="prev"`, `</users?page=3>; rel="next"`}}
+
+	tests := []struct {
+		name    string
+		header  string
+		regExp  string
+		want    string
+		wantErr bool
+	}{
+		{name: "capturing group", header: "Cache-Control", regExp: `max-age=(\d+)`, want: "3600"},
+		{name: "whole match", header: "Cache-Control", regExp: `max-age=\d+`, want: "max-age=3600"},
+		{name: "first matching value of repeated header", header: "Link", regExp: `<([^>]+)>; rel="next"`, want: "/users?page=3"},
+		{name: "no match", header: "Cache-Control", regExp: `no-store`, wantErr: true},
+		{name: "capturing group not participating in match", header: "Cache-Control", regExp: `max-age=\d+|(no-store)`, wantErr: true},
+		{name: "empty capturing group", header: "Cache-Control", regExp: `public()`, want: ""},
+		{name: "missing header", header: "X-Missing", regExp: `.*`, wantErr: true},
+		{name: "invalid regExp", header: "Cache-Control", regExp: `(`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{Header: header})
+
+			err := apiCtx.SaveHeaderRegExpMatch(tt.header, tt.regExp, "SAVED")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SaveHeaderRegExpMatch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got, _ := apiCtx.Cache.GetSaved("SAVED"); got != tt.want {
+				t.Errorf("SaveHeaderRegExpMatch() saved = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIContext_SaveCookie(t *testing.T) {
+	apiCtx := NewDefaultAPIContext(false, "")
+	if err := apiCtx.SaveCookie("session", "SESSION"); err == nil {
+		t.Errorf("SaveCookie() expected error when last response is missing")
+	}
+
+	apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{
+		Header: http.Header{"Set-Cookie": []string{"session=abc; HttpOnly", "theme=dark"}},
+	})
+
+	if err := apiCtx.SaveCookie("token", "TOKEN"); err == nil {
+		t.Errorf("SaveCookie() expected error when cookie is missing")
+	}
+
+	if err := apiCtx.SaveCookie("session", "SESSION"); err != nil {
+		t.Fatalf("SaveCookie() error = %v", err)
+	}
+
+	if got, _ := apiCtx.Cache.GetSaved("SESSION"); got != "abc" {
+		t.Errorf("SaveCookie() saved = %v, want %v", got, "abc")
+	}
+}
+
+// signedTestJWT returns JSON Web Token with given claims, signed with HS256 when key is []byte or RS256 when key is *rsa.PrivateKey.
+func signedTestJWT(t *testing.T, claims map[string]any, key any) string {
+	t.Helper()
+
+	header := map[string]any{"alg": "HS256", "typ": "JWT"}
+	if _, isRSA := key.(*rsa.PrivateKey); isRSA {
+		header = map[string]any{"alg": "RS256", "typ": "JWT", "kid": "main"}
+	}
+
+	headerData, _ := json.Marshal(header)
+	claimsData, _ := json.Marshal(claims)
+	input := base64.RawURLEncoding.EncodeToString(headerData) + "." + base64.RawURLEncoding.EncodeToString(claimsData)
+	digest := sha256.Sum256([]byte(input))
+
+	var signature []byte
+	switch k := key.(type) {
+	case []byte:
+		mac := hmac.New(sha256.New, k)
+		mac.Write([]byte(input))
+		signature = mac.Sum(nil)
+	case *rsa.PrivateKey:
+		var err error
+		if signature, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:]); err != nil {
+			t.Fatalf("could not sign token, err: %v", err)
+		}
+	}
+
+	return input + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestAPIContext_SaveJWT(t *testing.T) {
+	token := signedTestJWT(t, map[string]any{"sub": "user-1", "exp": 1700000000}, []byte("secret"))
+
+	tests := []struct {
+		name    string
+		save    func(apiCtx *APIContext) error
+		wantErr bool
+	}{
+		{name: "from node", save: func(apiCtx *APIContext) error { return apiCtx.SaveJWTFromNode(df.JSON, "access_token", "TOKEN") }},
+		{name: "from node of wrong type", save: func(apiCtx *APIContext) error { return apiCtx.SaveJWTFromNode(df.JSON, "expires_in", "TOKEN") }, wantErr: true},
+		{name: "from node without token", save: func(apiCtx *APIContext) error { return apiCtx.SaveJWTFromNode(df.JSON, "token_type", "TOKEN") }, wantErr: true},
+		{name: "from header with Bearer prefix", save: func(apiCtx *APIContext) error { return apiCtx.SaveJWTFromHeader("Authorization", "TOKEN") }},
+		{name: "from missing header", save: func(apiCtx *APIContext) error { return apiCtx.SaveJWTFromHeader("X-Token", "TOKEN") }, wantErr: true},
+		{name: "from cache", save: func(apiCtx *APIContext) error { return apiCtx.SaveJWTFromCache("RAW", "TOKEN") }},
+		{name: "from missing cache key", save: func(apiCtx *APIContext) error { return apiCtx.SaveJWTFromCache("OTHER", "TOKEN") }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save("RAW", token)
+			apiCtx.Cache.Save(httpcache.LastHTTPResponseCacheKey, &http.Response{
+				Header: http.Header{"Authorization": []string{"Bearer " + token}},
+				Body:   io.NopCloser(strings.NewReader(fmt.Sprintf(`{"access_token": "%s", "token_type": "Bearer", "expires_in": 3600}`, token))),
+			})
+
+			err := tt.save(apiCtx)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			got, err := apiCtx.TemplateEngine.Replace("{{.TOKEN.Header.alg}} {{.TOKEN.Claims.sub}} {{.TOKEN.Claims.exp}}", apiCtx.Cache.All())
+			if err != nil || got != "HS256 user-1 1700000000" {
+				t.Errorf("saved token got = %s, err = %v", got, err)
+			}
+
+			if err = apiCtx.SaveJWTPart("TOKEN", "claims", "CLAIMS"); err != nil {
+				t.Fatalf("SaveJWTPart() error = %v", err)
+			}
+
+			if err = apiCtx.SaveJWTPart("TOKEN", "Header", "HEADER"); err != nil {
+				t.Fatalf("SaveJWTPart() error = %v", err)
+			}
+
+			if err = apiCtx.SetCachedDocumentAsNodeSource("CLAIMS"); err != nil {
+				t.Fatalf("SetCachedDocumentAsNodeSource() error = %v", err)
+			}
+
+			if err = apiCtx.AssertNodeIsTypeAndValue(df.JSON, "sub", types.String, "user-1"); err != nil {
+				t.Errorf("claims should be checked with node assertions, err: %v", err)
+			}
+
+			if err = apiCtx.AssertNodeIsTypeAndValue(df.JSON, "exp", types.Number, "1700000000"); err != nil {
+				t.Errorf("claims should be checked with node assertions, err: %v", err)
+			}
+
+			if err = apiCtx.SetCachedDocumentAsNodeSource("HEADER"); err != nil {
+				t.Fatalf("SetCachedDocumentAsNodeSource() error = %v", err)
+			}
+
+			if err = apiCtx.AssertNodeIsTypeAndValue(df.JSON, "alg", types.String, "HS256"); err != nil {
+				t.Errorf("header should be checked with node assertions, err: %v", err)
+			}
+
+			if err = apiCtx.SetLastResponseAsNodeSource(); err != nil {
+				t.Fatalf("SetLastResponseAsNodeSource() error = %v", err)
+			}
+
+			if err = apiCtx.AssertNodeIsTypeAndValue(df.JSON, "token_type", types.String, "Bearer"); err != nil {
+				t.Errorf("last HTTP(s) response should not be changed by saving token parts, err: %v", err)
+			}
+
+			if err = apiCtx.SaveJWTPart("TOKEN", "signature", "SIGNATURE"); err == nil {
+				t.Errorf("SaveJWTPart() expected error for unknown part")
+			}
+
+			if err = apiCtx.SaveJWTPart("RAW", "claims", "CLAIMS"); err == nil {
+				t.Errorf("SaveJWTPart() expected error for value which is not decoded token")
+			}
+
+			if err = apiCtx.SetCachedDocumentAsNodeSource("MISSING"); err == nil {
+				t.Errorf("SetCachedDocumentAsNodeSource() expected error for missing cache key")
+			}
+		})
+	}
+}
+
+func TestAPIContext_AssertJWT(t *testing.T) {
+	now := time.Now().Unix()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("could not generate RSA key, err: %v", err)
+	}
+
+	publicKey, _ := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	publicKeyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}))
+
+	dir := t.TempDir()
+	jwksPath := filepath.Join(dir, "jwks.json")
+	jwks := fmt.Sprintf(`{"keys": [{"kty": "RSA", "kid": "main", "n": "%s", "e": "AQAB"}]}`, base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes()))
+	pemPath := filepath.Join(dir, "public.pem")
+	if err = os.WriteFile(jwksPath, []byte(jwks), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = os.WriteFile(pemPath, []byte(publicKeyPEM), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	valid := signedTestJWT(t, map[string]any{"iat": now - 10, "nbf": now - 10, "exp": now + 3600}, []byte("secret"))
+	expired := signedTestJWT(t, map[string]any{"iat": now - 7200, "exp": now - 3600}, []byte("secret"))
+	notYetValid := signedTestJWT(t, map[string]any{"nbf": now + 600, "exp": now + 3600}, []byte("secret"))
+	rsaSigned := signedTestJWT(t, map[string]any{"exp": now + 3600}, rsaKey)
+
+	tests := []struct {
+		name    string
+		token   string
+		assert  func(apiCtx *APIContext) error
+		wantErr bool
+	}{
+		{name: "not expired", token: valid, assert: func(apiCtx *APIContext) error { return apiCtx.AssertJWTIsNotExpired("TOKEN") }},
+		{name: "expired", token: expired, assert: func(apiCtx *APIContext) error { return apiCtx.AssertJWTIsNotExpired("TOKEN") }, wantErr: true},
+		{name: "not yet valid", token: notYetValid, assert: func(apiCtx *APIContext) error { return apiCtx.AssertJWTIsNotExpired("TOKEN") }, wantErr: true},
+		{name: "missing token", token: valid, assert: func(apiCtx *APIContext) error { return apiCtx.AssertJWTIsNotExpired("OTHER") }, wantErr: true},
+		{name: "issued within minute", token: valid, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertJWTTimeClaimIsWithin("TOKEN", "iat", time.Minute)
+		}},
+		{name: "expires in more than minute", token: valid, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertJWTTimeClaimIsWithin("TOKEN", "exp", time.Minute)
+		}, wantErr: true},
+		{name: "missing time claim", token: rsaSigned, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertJWTTimeClaimIsWithin("TOKEN", "iat", time.Minute)
+		}, wantErr: true},
+		{name: "valid HMAC signature", token: valid, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertJWTSignatureIsValidByHMAC("TOKEN", "{{.SECRET}}")
+		}},
+		{name: "invalid HMAC signature", token: valid, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertJWTSignatureIsValidByHMAC("TOKEN", "other")
+		}, wantErr: true},
+		{name: "valid signature by PEM", token: rsaSigned, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertJWTSignatureIsValidByPEM("TOKEN", publicKeyPEM)
+		}},
+		{name: "valid signature by PEM file", token: rsaSigned, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertJWTSignatureIsValidByPEM("TOKEN", pemPath)
+		}},
+		{name: "HMAC token verified by PEM", token: valid, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertJWTSignatureIsValidByPEM("TOKEN", publicKeyPEM)
+		}, wantErr: true},
+		{name: "valid signature by JWKS", token: rsaSigned, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertJWTSignatureIsValidByJWKS("TOKEN", jwksPath)
+		}},
+		{name: "missing JWKS file", token: rsaSigned, assert: func(apiCtx *APIContext) error {
+			return apiCtx.AssertJWTSignatureIsValidByJWKS("TOKEN", filepath.Join(dir, "missing.json"))
+		}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiCtx := NewDefaultAPIContext(false, "")
+			apiCtx.Cache.Save("RAW", tt.token)
+			apiCtx.Cache.Save("SECRET", "secret")
+			if err := apiCtx.SaveJWTFromCache("RAW", "TOKEN"); err != nil {
+				t.Fatalf("SaveJWTFromCache() error = %v", err)
+			}
+
+			if err := tt.assert(apiCtx); (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}